@@ -0,0 +1,17 @@
+data "violet_app" "current" {}
+
+check "violet_app" {
+  assert {
+    condition     = data.violet_app.current.environment == "production"
+    error_message = "Provider is configured with ${data.violet_app.current.environment} app ${data.violet_app.current.name}"
+  }
+}
+
+output "violet_app" {
+  value = {
+    id          = data.violet_app.current.id
+    name        = data.violet_app.current.name
+    environment = data.violet_app.current.environment
+    currencies  = data.violet_app.current.currencies
+  }
+}
@@ -0,0 +1,15 @@
+variable "username" {
+  type = string
+}
+
+variable "password" {
+  type = string
+}
+
+variable "app_id" {
+  type = string
+}
+
+variable "app_secret" {
+  type = string
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "violet_merchant" "by_id" {
+  id = 10042
+}
+
+data "violet_merchant" "by_store_url" {
+  store_url = "https://example-store.myshopify.com"
+}
+
+output "merchant_id" {
+  value = data.violet_merchant.by_store_url.id
+}
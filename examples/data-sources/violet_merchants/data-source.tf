@@ -0,0 +1,9 @@
+data "violet_merchants" "us_shopify" {
+  status   = "CONNECTED"
+  platform = "SHOPIFY"
+  country  = "US"
+}
+
+output "us_shopify_merchant_ids" {
+  value = data.violet_merchants.us_shopify.ids
+}
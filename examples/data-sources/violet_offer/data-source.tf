@@ -0,0 +1,7 @@
+data "violet_offer" "example" {
+  id = 48211
+}
+
+output "in_stock_sku_ids" {
+  value = [for sku in data.violet_offer.example.skus : sku.id if sku.in_stock]
+}
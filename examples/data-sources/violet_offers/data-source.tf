@@ -0,0 +1,12 @@
+data "violet_offers" "sneakers" {
+  merchant_id = 10042
+  query       = "sneakers"
+  available   = true
+  min_price   = 5000
+  max_price   = 20000
+  limit       = 20
+}
+
+output "sneaker_offer_ids" {
+  value = data.violet_offers.sneakers.ids
+}
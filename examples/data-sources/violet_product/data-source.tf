@@ -0,0 +1,7 @@
+data "violet_product" "example" {
+  id = "3f9c2a61e0b84d8c"
+}
+
+output "sku_prices_by_size" {
+  value = { for sku in data.violet_product.example.skus : lookup(sku.variant_attributes, "Size", sku.name) => sku.sale_price... }
+}
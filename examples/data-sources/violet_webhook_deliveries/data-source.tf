@@ -0,0 +1,10 @@
+data "violet_webhook_deliveries" "example" {
+  webhook_id = 2214
+  since      = timeadd(plantimestamp(), "-24h")
+  outcome    = "failure"
+  limit      = 20
+}
+
+output "failed_deliveries" {
+  value = data.violet_webhook_deliveries.example.deliveries
+}
@@ -0,0 +1,16 @@
+check "orders_webhook_health" {
+  data "violet_webhook_health" "orders" {
+    webhook_id = 2214
+    window     = "6h"
+  }
+
+  assert {
+    condition     = data.violet_webhook_health.orders.consecutive_failures < 5
+    error_message = "Orders webhook failed ${data.violet_webhook_health.orders.consecutive_failures} deliveries in a row"
+  }
+
+  assert {
+    condition     = data.violet_webhook_health.orders.failure_rate < 0.1
+    error_message = "Orders webhook failure rate is ${data.violet_webhook_health.orders.failure_rate}"
+  }
+}
@@ -0,0 +1,8 @@
+data "violet_webhook_payload_schema" "example" {
+  event = "ORDER_UPDATED"
+}
+
+resource "local_file" "order_schema" {
+  filename = "${path.module}/schemas/order.json"
+  content  = data.violet_webhook_payload_schema.example.schema
+}
@@ -0,0 +1,13 @@
+data "violet_webhook_sample_payload" "example" {
+  event     = "ORDER_COMPLETED"
+  secret    = var.app_secret
+  timestamp = "2024-05-14T10:25:41Z"
+}
+
+output "order_completed_fixture" {
+  sensitive = true
+  value     = {
+    body    = data.violet_webhook_sample_payload.example.body
+    headers = data.violet_webhook_sample_payload.example.headers
+  }
+}
@@ -0,0 +1,3 @@
+output "valid" {
+  value = provider::violet::verify_webhook_signature(var.body, var.app_secret, var.hmac_header)
+}
@@ -0,0 +1,3 @@
+output "signature" {
+  value = provider::violet::webhook_signature(jsonencode({ id = 1 }), var.app_secret)
+}
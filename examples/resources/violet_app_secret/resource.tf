@@ -0,0 +1,13 @@
+resource "violet_app_secret" "example" {
+  rotation_period = "2160h"
+
+  rotation_triggers = {
+    reason = "scheduled"
+  }
+}
+
+# Wire the new secret into the secret store app_secret of the provider is read from
+output "violet_app_secret" {
+  value     = violet_app_secret.example.secret
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# App settings can be imported using app id. Settings of the app the provider is configured with are always imported
+terraform import violet_app_settings.example 10099
@@ -0,0 +1,7 @@
+resource "violet_app_settings" "example" {
+  display_name      = "Example Store"
+  support_email     = "support@example.com"
+  default_currency  = "USD"
+  allowed_countries = ["US", "CA", "GB"]
+  guest_checkout    = true
+}
@@ -0,0 +1,2 @@
+# App webhooks can be imported using app id. Every webhook that is not ignored becomes managed
+terraform import violet_app_webhooks.example 10099
@@ -0,0 +1,18 @@
+resource "violet_app_webhooks" "example" {
+  webhooks = [
+    {
+      event           = "ORDER_UPDATED"
+      remote_endpoint = "https://test.com/orders"
+    },
+    {
+      event           = "OFFER_UPDATED"
+      remote_endpoint = "https://test.com/offers"
+    },
+  ]
+
+  # Webhooks managed by other tooling
+  ignore = [
+    "^MERCHANT_.*",
+    "\\|https://other\\.test\\.com/",
+  ]
+}
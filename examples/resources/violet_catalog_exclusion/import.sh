@@ -0,0 +1,2 @@
+# Catalog exclusions can be imported using app id. Exclusions of the app the provider is configured with are always imported
+terraform import violet_catalog_exclusion.example 10099
@@ -0,0 +1,5 @@
+resource "violet_catalog_exclusion" "example" {
+  merchant_ids = [10042]
+  offer_ids    = [48211, 48212]
+  categories   = ["Firearms", "Tobacco"]
+}
@@ -0,0 +1,2 @@
+# Collection can be imported using its id
+terraform import violet_collection.example 3120
@@ -0,0 +1,12 @@
+data "violet_offers" "summer" {
+  query     = "summer dress"
+  available = true
+  limit     = 12
+}
+
+resource "violet_collection" "example" {
+  name        = "Summer picks"
+  description = "Hand-picked dresses for the season"
+  image_url   = "https://example.com/images/summer.jpg"
+  offer_ids   = data.violet_offers.summer.ids
+}
@@ -0,0 +1,20 @@
+variable "username" {
+  type = string
+}
+
+variable "password" {
+  type = string
+}
+
+variable "app_id" {
+  type = string
+}
+
+variable "app_secret" {
+  type = string
+}
+
+variable "sandbox" {
+  type    = bool
+  default = false
+}
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Commission rate can be imported using merchant id
+terraform import violet_commission_rate.example 10042
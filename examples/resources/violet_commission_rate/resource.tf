@@ -0,0 +1,8 @@
+data "violet_merchant" "example" {
+  store_url = "https://example-store.myshopify.com"
+}
+
+resource "violet_commission_rate" "example" {
+  merchant_id     = data.violet_merchant.example.id
+  commission_rate = 12.5
+}
@@ -0,0 +1,2 @@
+# Global commission can be imported using app id. Commission of the app the provider is configured with is always imported
+terraform import violet_global_commission.example 10099
@@ -0,0 +1,4 @@
+resource "violet_global_commission" "example" {
+  commission_rate = 10
+  locked          = true
+}
@@ -1,2 +1,5 @@
 # Webhook can be imported using id
-terraform import violet_webhook.example 10198
\ No newline at end of file
+terraform import violet_webhook.example 10198
+
+# or using event and remote endpoint separated by |
+terraform import violet_webhook.example 'OFFER_UPDATED|https://test.com/'
@@ -0,0 +1,2 @@
+# Webhook subscription can be imported using remote endpoint
+terraform import violet_webhook_subscription.example https://test.com/
@@ -0,0 +1,8 @@
+resource "violet_webhook_subscription" "example" {
+  remote_endpoint = "https://test.com/"
+  events = [
+    "ORDER_UPDATED",
+    "ORDER_SHIPPED",
+    "ORDER_DELIVERED",
+  ]
+}
@@ -0,0 +1,19 @@
+resource "violet_webhook" "example" {
+  event           = "ORDER_UPDATED"
+  remote_endpoint = "https://test.com/"
+}
+
+resource "violet_webhook_test" "example" {
+  webhook_id = violet_webhook.example.id
+
+  triggers = {
+    remote_endpoint = violet_webhook.example.remote_endpoint
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = self.status_code < 300
+      error_message = "Webhook receiver responded with ${self.status_code}"
+    }
+  }
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &appDataSource{}
+	_ datasource.DataSourceWithConfigure = &appDataSource{}
+)
+
+const (
+	appEnvironmentSandbox    = "sandbox"
+	appEnvironmentProduction = "production"
+)
+
+func AppDataSource() datasource.DataSource {
+	return &appDataSource{}
+}
+
+type appDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *appDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *appDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_app"
+}
+
+type appModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Environment      types.String `tfsdk:"environment"`
+	Owner            types.String `tfsdk:"owner"`
+	Status           types.String `tfsdk:"status"`
+	Currencies       types.List   `tfsdk:"currencies"`
+	DateCreated      types.String `tfsdk:"date_created"`
+	DateLastModified types.String `tfsdk:"date_last_modified"`
+}
+
+func (d *appDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get data of Violet app",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "App id. Defaults to app_id the provider is configured with",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of app",
+			},
+			"environment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Violet environment of app, " + appEnvironmentSandbox + " or " + appEnvironmentProduction,
+			},
+			"owner": schema.StringAttribute{
+				Computed:    true,
+				Description: "Owner of app",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of app",
+			},
+			"currencies": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Currencies configured for app",
+			},
+			"date_created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date of app",
+			},
+			"date_last_modified": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of last modification of app",
+			},
+		},
+	}
+}
+
+func (d *appDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data appModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := d.client.AppId
+	if !data.Id.IsNull() {
+		id = data.Id.ValueString()
+	}
+
+	tflog.Info(ctx, "Read appDataSource", map[string]interface{}{
+		"id": id,
+	})
+
+	err, app := d.client.GetApp(ctx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet app id: %s", id),
+			"error getting app: "+err.Error(),
+		)
+		return
+	}
+
+	currencies, diags := types.ListValueFrom(ctx, types.StringType, app.Currencies)
+	resp.Diagnostics.Append(diags...)
+
+	environment := appEnvironmentProduction
+	if d.client.Sandbox {
+		environment = appEnvironmentSandbox
+	}
+
+	state := appModel{
+		Id:               types.StringValue(strconv.FormatInt(app.Id, 10)),
+		Name:             types.StringValue(app.Name),
+		Environment:      types.StringValue(environment),
+		Owner:            types.StringValue(app.Owner),
+		Status:           types.StringValue(app.Status),
+		Currencies:       currencies,
+		DateCreated:      types.StringValue(app.DateCreated),
+		DateLastModified: types.StringValue(app.DateLastModified),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
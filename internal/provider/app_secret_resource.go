@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AppSecretResource{}
+	_ resource.ResourceWithConfigure      = &AppSecretResource{}
+	_ resource.ResourceWithModifyPlan     = &AppSecretResource{}
+	_ resource.ResourceWithValidateConfig = &AppSecretResource{}
+)
+
+// NewAppSecretResource is a helper function to simplify the provider implementation.
+func NewAppSecretResource() resource.Resource {
+	return &AppSecretResource{}
+}
+
+// AppSecretResource rotates the secret of the configured app whenever it is created or replaced.
+type AppSecretResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *AppSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_secret"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AppSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type AppSecretResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	RotationPeriod   types.String `tfsdk:"rotation_period"`
+	Secret           types.String `tfsdk:"secret"`
+	RotatedAt        types.String `tfsdk:"rotated_at"`
+}
+
+// Schema defines the schema for the resource.
+func (r *AppSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to rotate the secret of the Violet app. The secret is rotated on create and whenever rotation triggers change or the rotation period passes. " +
+			"The provider switches to the new secret for the rest of the run, app_secret of the provider has to be updated before the next one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "App Id the secret belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that cause the secret to be rotated when changed",
+			},
+			"rotation_period": schema.StringAttribute{
+				Optional:    true,
+				Description: "Duration, e.g. 720h, after which the secret is rotated on the next apply",
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The new app secret",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time the secret was rotated",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppSecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AppSecretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.RotationPeriod.IsNull() || config.RotationPeriod.IsUnknown() {
+		return
+	}
+
+	period, err := time.ParseDuration(config.RotationPeriod.ValueString())
+	if err != nil || period <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotation_period"),
+			"Invalid rotation period",
+			fmt.Sprintf("Expected positive duration, e.g. 720h, got: %q", config.RotationPeriod.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan plans a rotation once the rotation period has passed since the last one.
+func (r *AppSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AppSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.RotationPeriod.IsNull() || plan.RotationPeriod.IsUnknown() {
+		return
+	}
+
+	period, err := time.ParseDuration(plan.RotationPeriod.ValueString())
+	if err != nil {
+		return
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, plan.RotatedAt.ValueString())
+	if err != nil || time.Now().Before(rotatedAt.Add(period)) {
+		return
+	}
+
+	tflog.Info(ctx, "App secret rotation period passed", map[string]interface{}{
+		"rotated_at": plan.RotatedAt.ValueString(),
+		"period":     plan.RotationPeriod.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotated_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("rotated_at"))
+}
+
+// Create rotates the app secret.
+func (r *AppSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppSecretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err, secret := r.client.RotateAppSecret(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error rotating secret of Violet app id: %s", r.client.AppId),
+			"Rotate app secret failed: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Violet app secret rotated",
+		"The previous app secret no longer works. Update app_secret of the provider and every other consumer of the secret with the new value.",
+	)
+
+	plan.Id = types.StringValue(r.client.AppId)
+	plan.Secret = types.StringValue(secret)
+	plan.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the recorded secret, Violet does not return it after rotation.
+func (r *AppSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update writes changes of rotation_period to the state, every other change requires replacement.
+func (r *AppSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AppSecretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the secret from the Terraform state, the current secret stays valid.
+func (r *AppSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AppSettingsResource{}
+	_ resource.ResourceWithConfigure      = &AppSettingsResource{}
+	_ resource.ResourceWithImportState    = &AppSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &AppSettingsResource{}
+)
+
+var (
+	currencyCodeRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryCodeRegexp  = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// NewAppSettingsResource is a helper function to simplify the provider implementation.
+func NewAppSettingsResource() resource.Resource {
+	return &AppSettingsResource{}
+}
+
+// AppSettingsResource manages settings of the configured app. There is exactly one per app.
+type AppSettingsResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *AppSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_settings"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AppSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type AppSettingsResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	SupportEmail     types.String `tfsdk:"support_email"`
+	DefaultCurrency  types.String `tfsdk:"default_currency"`
+	AllowedCountries types.Set    `tfsdk:"allowed_countries"`
+	CheckoutMode     types.String `tfsdk:"checkout_mode"`
+	GuestCheckout    types.Bool   `tfsdk:"guest_checkout"`
+}
+
+// Schema defines the schema for the resource.
+func (r *AppSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage settings of the Violet app. " +
+			"Settings that are not configured are left as they are in Violet. Destroying the resource does not reset the settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "App Id the settings belong to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the app shown to shoppers and merchants",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"support_email": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address shoppers and merchants can contact for support",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_currency": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ISO 4217 code of the currency prices are shown in by default, e.g. USD",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_countries": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "ISO 3166-1 alpha-2 codes of countries shoppers can check out from, e.g. US",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"checkout_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Checkout behaviour of the app as named by Violet",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guest_checkout": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether shoppers can check out without an account",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AppSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SupportEmail.IsNull() && !config.SupportEmail.IsUnknown() {
+		if _, err := mail.ParseAddress(config.SupportEmail.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("support_email"),
+				"Invalid support email",
+				fmt.Sprintf("Expected email address, got: %q", config.SupportEmail.ValueString()),
+			)
+		}
+	}
+
+	if !config.DefaultCurrency.IsNull() && !config.DefaultCurrency.IsUnknown() && !currencyCodeRegexp.MatchString(config.DefaultCurrency.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_currency"),
+			"Invalid default currency",
+			fmt.Sprintf("Expected upper case ISO 4217 currency code, e.g. USD, got: %q", config.DefaultCurrency.ValueString()),
+		)
+	}
+
+	if config.AllowedCountries.IsNull() || config.AllowedCountries.IsUnknown() {
+		return
+	}
+
+	var countries []types.String
+	resp.Diagnostics.Append(config.AllowedCountries.ElementsAs(ctx, &countries, false)...)
+
+	for _, country := range countries {
+		if country.IsUnknown() || country.IsNull() {
+			continue
+		}
+
+		if !countryCodeRegexp.MatchString(country.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allowed_countries"),
+				"Invalid allowed country",
+				fmt.Sprintf("Expected upper case ISO 3166-1 alpha-2 country code, e.g. US, got: %q", country.ValueString()),
+			)
+		}
+	}
+}
+
+// ImportState imports settings of the configured app, the import id is ignored.
+func (r *AppSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.client.AppId)...)
+}
+
+// Create takes over settings of the app, updating the configured ones.
+func (r *AppSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AppSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read app settings resource")
+
+	err, settings := r.client.GetAppSettings(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Violet app settings",
+			"Get app settings failed: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags := newAppSettingsResourceModel(ctx, r.client.AppId, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AppSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AppSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete removes the settings from the Terraform state, they stay in Violet as they are.
+func (r *AppSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing app settings from state, settings are left unchanged in Violet")
+}
+
+// apply saves the planned settings in Violet. Settings unknown in the plan keep their current value.
+func (r *AppSettingsResource) apply(ctx context.Context, plan AppSettingsResourceModel) (AppSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	err, settings := r.client.GetAppSettings(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Error updating Violet app settings",
+			"Get app settings failed: "+err.Error(),
+		)
+		return AppSettingsResourceModel{}, diags
+	}
+
+	if !plan.DisplayName.IsUnknown() && !plan.DisplayName.IsNull() {
+		settings.DisplayName = plan.DisplayName.ValueString()
+	}
+	if !plan.SupportEmail.IsUnknown() && !plan.SupportEmail.IsNull() {
+		settings.SupportEmail = plan.SupportEmail.ValueString()
+	}
+	if !plan.DefaultCurrency.IsUnknown() && !plan.DefaultCurrency.IsNull() {
+		settings.DefaultCurrency = plan.DefaultCurrency.ValueString()
+	}
+	if !plan.AllowedCountries.IsUnknown() && !plan.AllowedCountries.IsNull() {
+		settings.AllowedCountries = []string{}
+		diags.Append(plan.AllowedCountries.ElementsAs(ctx, &settings.AllowedCountries, false)...)
+	}
+	if !plan.CheckoutMode.IsUnknown() && !plan.CheckoutMode.IsNull() {
+		settings.CheckoutMode = plan.CheckoutMode.ValueString()
+	}
+	if !plan.GuestCheckout.IsUnknown() && !plan.GuestCheckout.IsNull() {
+		settings.GuestCheckout = plan.GuestCheckout.ValueBool()
+	}
+
+	if diags.HasError() {
+		return AppSettingsResourceModel{}, diags
+	}
+
+	err, settings = r.client.UpdateAppSettings(ctx, settings)
+
+	if err != nil {
+		diags.AddError(
+			"Error updating Violet app settings",
+			"Update app settings failed: "+err.Error(),
+		)
+		return AppSettingsResourceModel{}, diags
+	}
+
+	state, d := newAppSettingsResourceModel(ctx, r.client.AppId, settings)
+	diags.Append(d...)
+
+	return state, diags
+}
+
+func newAppSettingsResourceModel(ctx context.Context, appId string, settings violet.VioletAppSettings) (AppSettingsResourceModel, diag.Diagnostics) {
+	allowedCountries := settings.AllowedCountries
+	if allowedCountries == nil {
+		allowedCountries = []string{}
+	}
+
+	countries, diags := types.SetValueFrom(ctx, types.StringType, allowedCountries)
+
+	return AppSettingsResourceModel{
+		Id:               types.StringValue(appId),
+		DisplayName:      types.StringValue(settings.DisplayName),
+		SupportEmail:     types.StringValue(settings.SupportEmail),
+		DefaultCurrency:  types.StringValue(settings.DefaultCurrency),
+		AllowedCountries: countries,
+		CheckoutMode:     types.StringValue(settings.CheckoutMode),
+		GuestCheckout:    types.BoolValue(settings.GuestCheckout),
+	}, diags
+}
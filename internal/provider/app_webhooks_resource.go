@@ -0,0 +1,511 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AppWebhooksResource{}
+	_ resource.ResourceWithConfigure      = &AppWebhooksResource{}
+	_ resource.ResourceWithImportState    = &AppWebhooksResource{}
+	_ resource.ResourceWithModifyPlan     = &AppWebhooksResource{}
+	_ resource.ResourceWithValidateConfig = &AppWebhooksResource{}
+)
+
+// NewAppWebhooksResource is a helper function to simplify the provider implementation.
+func NewAppWebhooksResource() resource.Resource {
+	return &AppWebhooksResource{}
+}
+
+// AppWebhooksResource authoritatively manages all webhooks of the configured app.
+type AppWebhooksResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *AppWebhooksResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_webhooks"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AppWebhooksResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type AppWebhooksResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Webhooks          types.Set    `tfsdk:"webhooks"`
+	Ignore            types.List   `tfsdk:"ignore"`
+	WebhookIds        types.Map    `tfsdk:"webhook_ids"`
+	UnmanagedWebhooks types.Set    `tfsdk:"unmanaged_webhooks"`
+}
+
+type appWebhookModel struct {
+	Event          types.String `tfsdk:"event"`
+	RemoteEndpoint types.String `tfsdk:"remote_endpoint"`
+}
+
+var appWebhookAttrTypes = map[string]attr.Type{
+	"event":           types.StringType,
+	"remote_endpoint": types.StringType,
+}
+
+// webhookKey identifies a webhook by its event and remote endpoint, in the same format as violet_webhook import.
+func webhookKey(event string, remoteEndpoint string) string {
+	return event + "|" + remoteEndpoint
+}
+
+// Schema defines the schema for the resource.
+func (r *AppWebhooksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to authoritatively manage all webhooks of the Violet app. " +
+			"Webhooks that are not in the configuration and do not match any of the ignore patterns are deleted on apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "App Id the webhooks belong to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhooks": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Complete set of webhooks of the app",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event": schema.StringAttribute{
+							Required:    true,
+							Description: "Event webhook will be subscribed to",
+						},
+						"remote_endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: "Endpoint that webhook will be publishing to",
+						},
+					},
+				},
+			},
+			"ignore": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Regular expressions matched against EVENT|remote_endpoint of webhooks that are owned by other tooling and must not be deleted",
+			},
+			"webhook_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Map of EVENT|remote_endpoint to id of the managed webhook",
+			},
+			"unmanaged_webhooks": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "EVENT|remote_endpoint of webhooks found in Violet that are not in the configuration, or are duplicates of a managed webhook. They are deleted on the next apply",
+			},
+		},
+	}
+}
+
+func (r *AppWebhooksResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AppWebhooksResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Ignore.IsNull() || config.Ignore.IsUnknown() {
+		return
+	}
+
+	var patterns []types.String
+	resp.Diagnostics.Append(config.Ignore.ElementsAs(ctx, &patterns, false)...)
+
+	for i, pattern := range patterns {
+		if pattern.IsUnknown() || pattern.IsNull() {
+			continue
+		}
+
+		if _, err := regexp.Compile(pattern.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ignore").AtListIndex(i),
+				"Invalid ignore pattern",
+				fmt.Sprintf("Pattern %q is not a valid regular expression: %s", pattern.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// ModifyPlan lists webhooks that will be deleted, so they are visible when reviewing the plan.
+func (r *AppWebhooksResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan AppWebhooksResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Once applied, there are no unmanaged webhooks left.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_webhooks"), []string{})...)
+
+	if plan.Webhooks.IsUnknown() || plan.Ignore.IsUnknown() {
+		return
+	}
+
+	// Webhooks and ignore patterns with values known only after apply could match existing webhooks,
+	// which would be listed as deleted by mistake, so nothing is listed until all of them are known.
+	var webhooks []appWebhookModel
+	resp.Diagnostics.Append(plan.Webhooks.ElementsAs(ctx, &webhooks, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if webhook.Event.IsUnknown() || webhook.RemoteEndpoint.IsUnknown() {
+			return
+		}
+	}
+	for _, pattern := range plan.Ignore.Elements() {
+		if pattern.IsUnknown() {
+			return
+		}
+	}
+
+	desired, ignore, diags := r.desiredWebhooks(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err, existing := r.client.ListWebhooks(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error planning Violet app webhooks",
+			"Listing webhooks failed: "+err.Error(),
+		)
+		return
+	}
+
+	_, toRemove := partitionWebhooks(existing, desired, ignore, false)
+
+	if len(toRemove) > 0 {
+		keys := make([]string, 0, len(toRemove))
+		for _, webhook := range toRemove {
+			keys = append(keys, fmt.Sprintf("%s (id: %d)", webhookKey(webhook.Event, webhook.RemoteEndpoint), webhook.Id))
+		}
+		sort.Strings(keys)
+
+		resp.Diagnostics.AddWarning(
+			"Unmanaged Violet webhooks will be deleted",
+			"The following webhooks are not in the configuration or duplicate a managed webhook, and will be deleted on apply:\n"+strings.Join(keys, "\n"),
+		)
+	}
+}
+
+func (r *AppWebhooksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.client.AppId)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AppWebhooksResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppWebhooksResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, &resp.State)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AppWebhooksResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState AppWebhooksResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read app webhooks resource")
+
+	err, webhooks := r.client.ListWebhooks(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Violet app webhooks",
+			"Listing webhooks failed: "+err.Error(),
+		)
+		return
+	}
+
+	desired, ignore, diags := r.desiredWebhooks(ctx, oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resource adopts every webhook that is not ignored.
+	managed, unmanaged := partitionWebhooks(webhooks, desired, ignore, oldState.Webhooks.IsNull())
+
+	state, diags := newAppWebhooksResourceModel(ctx, r.client.AppId, oldState.Ignore, managed, unmanaged)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AppWebhooksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AppWebhooksResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, &resp.State)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AppWebhooksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AppWebhooksResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookIds := map[string]int64{}
+	resp.Diagnostics.Append(state.WebhookIds.ElementsAs(ctx, &webhookIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Delete app webhooks resource", map[string]interface{}{
+		"count": len(webhookIds),
+	})
+
+	for _, key := range sortedKeys(webhookIds) {
+		id := webhookIds[key]
+		err := r.client.DeleteWebhook(ctx, id)
+
+		if err != nil && !violet.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error deleting Violet webhook id: %d", id),
+				"Delete webhook failed: "+err.Error(),
+			)
+		}
+	}
+}
+
+// apply deletes unmanaged webhooks, creates missing ones and saves the resulting state.
+func (r *AppWebhooksResource) apply(ctx context.Context, plan AppWebhooksResourceModel, state *tfsdk.State) diag.Diagnostics {
+	desired, ignore, diags := r.desiredWebhooks(ctx, plan)
+	if diags.HasError() {
+		return diags
+	}
+
+	err, webhooks := r.client.ListWebhooks(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Error applying Violet app webhooks",
+			"Listing webhooks failed: "+err.Error(),
+		)
+		return diags
+	}
+
+	managed, unmanaged := partitionWebhooks(webhooks, desired, ignore, false)
+
+	var failed []violet.VioletWebhook
+
+	for _, webhook := range unmanaged {
+		tflog.Warn(ctx, "Deleting unmanaged webhook", map[string]interface{}{
+			"id":              webhook.Id,
+			"event":           webhook.Event,
+			"remote_endpoint": webhook.RemoteEndpoint,
+		})
+
+		err := r.client.DeleteWebhook(ctx, webhook.Id)
+
+		if err != nil && !violet.IsNotFound(err) {
+			diags.AddError(
+				fmt.Sprintf("Error deleting Violet webhook id: %d", webhook.Id),
+				"Delete webhook failed: "+err.Error(),
+			)
+			failed = append(failed, webhook)
+		}
+	}
+
+	for _, key := range sortedKeys(desired) {
+		if _, ok := managed[key]; ok {
+			continue
+		}
+
+		err, webhook := r.client.CreateWebhook(ctx, desired[key])
+
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error creating Violet webhook %s", key),
+				"Creating webhook failed: "+err.Error(),
+			)
+			continue
+		}
+
+		managed[key] = webhook
+	}
+
+	newState, d := newAppWebhooksResourceModel(ctx, r.client.AppId, plan.Ignore, managed, failed)
+	diags.Append(d...)
+	diags.Append(state.Set(ctx, newState)...)
+
+	return diags
+}
+
+// desiredWebhooks returns webhooks declared in the model keyed by webhookKey and compiled ignore patterns.
+func (r *AppWebhooksResource) desiredWebhooks(ctx context.Context, model AppWebhooksResourceModel) (map[string]violet.CreateWebhookInput, []*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var webhooks []appWebhookModel
+	diags.Append(model.Webhooks.ElementsAs(ctx, &webhooks, true)...)
+
+	var patterns []string
+	diags.Append(model.Ignore.ElementsAs(ctx, &patterns, true)...)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	desired := make(map[string]violet.CreateWebhookInput, len(webhooks))
+	for _, webhook := range webhooks {
+		input := violet.CreateWebhookInput{
+			Event:          webhook.Event.ValueString(),
+			RemoteEndpoint: webhook.RemoteEndpoint.ValueString(),
+		}
+		desired[webhookKey(input.Event, input.RemoteEndpoint)] = input
+	}
+
+	ignore := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ignore"), "Invalid ignore pattern", err.Error())
+			continue
+		}
+		ignore = append(ignore, re)
+	}
+
+	return desired, ignore, diags
+}
+
+// partitionWebhooks splits webhooks into managed ones keyed by webhookKey and unmanaged ones that are neither
+// desired nor ignored. When adoptAll is set, every webhook that is not ignored is managed.
+// Only the webhook with the lowest id is managed for each key, its duplicates are unmanaged, so they get deleted.
+func partitionWebhooks(webhooks []violet.VioletWebhook, desired map[string]violet.CreateWebhookInput, ignore []*regexp.Regexp, adoptAll bool) (map[string]violet.VioletWebhook, []violet.VioletWebhook) {
+	sorted := make([]violet.VioletWebhook, len(webhooks))
+	copy(sorted, webhooks)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	managed := map[string]violet.VioletWebhook{}
+	var unmanaged []violet.VioletWebhook
+
+	for _, webhook := range sorted {
+		key := webhookKey(webhook.Event, webhook.RemoteEndpoint)
+		_, isDesired := desired[key]
+		_, isManaged := managed[key]
+
+		switch {
+		case isManaged:
+			unmanaged = append(unmanaged, webhook)
+		case isDesired || (adoptAll && !matchesAny(ignore, key)):
+			managed[key] = webhook
+		case !matchesAny(ignore, key):
+			unmanaged = append(unmanaged, webhook)
+		}
+	}
+
+	return managed, unmanaged
+}
+
+func matchesAny(patterns []*regexp.Regexp, s string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newAppWebhooksResourceModel(ctx context.Context, appId string, ignore types.List, managed map[string]violet.VioletWebhook, unmanaged []violet.VioletWebhook) (AppWebhooksResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	webhookModels := make([]appWebhookModel, 0, len(managed))
+	webhookIds := make(map[string]int64, len(managed))
+
+	for key, webhook := range managed {
+		webhookModels = append(webhookModels, appWebhookModel{
+			Event:          types.StringValue(webhook.Event),
+			RemoteEndpoint: types.StringValue(webhook.RemoteEndpoint),
+		})
+		webhookIds[key] = webhook.Id
+	}
+
+	// Duplicates of a webhook share the key, which must appear once in the set.
+	unmanagedKeys := make([]string, 0, len(unmanaged))
+	seen := make(map[string]bool, len(unmanaged))
+	for _, webhook := range unmanaged {
+		key := webhookKey(webhook.Event, webhook.RemoteEndpoint)
+		if !seen[key] {
+			seen[key] = true
+			unmanagedKeys = append(unmanagedKeys, key)
+		}
+	}
+
+	webhooks, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: appWebhookAttrTypes}, webhookModels)
+	diags.Append(d...)
+
+	ids, d := types.MapValueFrom(ctx, types.Int64Type, webhookIds)
+	diags.Append(d...)
+
+	unmanagedWebhooks, d := types.SetValueFrom(ctx, types.StringType, unmanagedKeys)
+	diags.Append(d...)
+
+	return AppWebhooksResourceModel{
+		Id:                types.StringValue(appId),
+		Webhooks:          webhooks,
+		Ignore:            ignore,
+		WebhookIds:        ids,
+		UnmanagedWebhooks: unmanagedWebhooks,
+	}, diags
+}
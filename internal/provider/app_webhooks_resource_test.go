@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+func TestPartitionWebhooks(t *testing.T) {
+	webhooks := []violet.VioletWebhook{
+		{Id: 3, Event: "ORDER_UPDATED", RemoteEndpoint: "https://example.com/hooks"},
+		{Id: 1, Event: "ORDER_UPDATED", RemoteEndpoint: "https://example.com/hooks"},
+		{Id: 2, Event: "ORDER_SHIPPED", RemoteEndpoint: "https://example.com/hooks"},
+		{Id: 4, Event: "OFFER_ADDED", RemoteEndpoint: "https://other.example.com/hooks"},
+		{Id: 5, Event: "OFFER_ADDED", RemoteEndpoint: "https://other.example.com/hooks"},
+	}
+
+	desired := map[string]violet.CreateWebhookInput{
+		webhookKey("ORDER_UPDATED", "https://example.com/hooks"): {Event: "ORDER_UPDATED", RemoteEndpoint: "https://example.com/hooks"},
+	}
+	ignore := []*regexp.Regexp{regexp.MustCompile(`other\.example\.com`)}
+
+	tests := map[string]struct {
+		adoptAll      bool
+		wantManaged   map[string]int64
+		wantUnmanaged []int64
+	}{
+		"desired webhooks": {
+			wantManaged: map[string]int64{
+				"ORDER_UPDATED|https://example.com/hooks": 1,
+			},
+			wantUnmanaged: []int64{2, 3},
+		},
+		"adopt all": {
+			adoptAll: true,
+			wantManaged: map[string]int64{
+				"ORDER_UPDATED|https://example.com/hooks": 1,
+				"ORDER_SHIPPED|https://example.com/hooks": 2,
+			},
+			wantUnmanaged: []int64{3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			managed, unmanaged := partitionWebhooks(webhooks, desired, ignore, test.adoptAll)
+
+			if len(managed) != len(test.wantManaged) {
+				t.Errorf("expected managed %v, got %v", test.wantManaged, managed)
+			}
+			for key, id := range test.wantManaged {
+				if managed[key].Id != id {
+					t.Errorf("expected %s to be managed as id %d, got %d", key, id, managed[key].Id)
+				}
+			}
+
+			unmanagedIds := make([]int64, 0, len(unmanaged))
+			for _, webhook := range unmanaged {
+				unmanagedIds = append(unmanagedIds, webhook.Id)
+			}
+			sort.Slice(unmanagedIds, func(i, j int) bool { return unmanagedIds[i] < unmanagedIds[j] })
+
+			if len(unmanagedIds) != len(test.wantUnmanaged) {
+				t.Fatalf("expected unmanaged %v, got %v", test.wantUnmanaged, unmanagedIds)
+			}
+			for i := range unmanagedIds {
+				if unmanagedIds[i] != test.wantUnmanaged[i] {
+					t.Errorf("expected unmanaged %v, got %v", test.wantUnmanaged, unmanagedIds)
+				}
+			}
+		})
+	}
+}
+
+func TestAppWebhooksResourceModifyPlanWarning(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"content": [
+			{"id": 1, "event": "ORDER_UPDATED", "remote_endpoint": "https://example.com/hooks"},
+			{"id": 2, "event": "ORDER_SHIPPED", "remote_endpoint": "https://example.com/hooks"},
+			{"id": 3, "event": "ORDER_UPDATED", "remote_endpoint": "https://example.com/hooks"}
+		], "last": true, "total_pages": 1}`)
+	}))
+	defer server.Close()
+
+	r := &AppWebhooksResource{client: &violet.VioletClient{AppId: "10099", BaseUrl: server.URL + "/"}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	tests := map[string]struct {
+		webhooks    []appWebhookModel
+		wantWarning []string
+		notWarning  []string
+	}{
+		"known webhooks": {
+			webhooks: []appWebhookModel{
+				{Event: types.StringValue("ORDER_UPDATED"), RemoteEndpoint: types.StringValue("https://example.com/hooks")},
+			},
+			wantWarning: []string{"ORDER_SHIPPED|https://example.com/hooks (id: 2)", "ORDER_UPDATED|https://example.com/hooks (id: 3)"},
+			notWarning:  []string{"(id: 1)"},
+		},
+		"unknown remote endpoint": {
+			webhooks: []appWebhookModel{
+				{Event: types.StringValue("ORDER_UPDATED"), RemoteEndpoint: types.StringUnknown()},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			webhooks, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: appWebhookAttrTypes}, test.webhooks)
+			if diags.HasError() {
+				t.Fatalf("webhooks value: %v", diags)
+			}
+
+			plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			diags = plan.Set(ctx, AppWebhooksResourceModel{
+				Id:                types.StringValue("10099"),
+				Webhooks:          webhooks,
+				Ignore:            types.ListNull(types.StringType),
+				WebhookIds:        types.MapUnknown(types.Int64Type),
+				UnmanagedWebhooks: types.SetUnknown(types.StringType),
+			})
+			if diags.HasError() {
+				t.Fatalf("set plan: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: plan.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			r.ModifyPlan(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var warning string
+			for _, d := range resp.Diagnostics.Warnings() {
+				warning += d.Detail()
+			}
+
+			if len(test.wantWarning) == 0 && warning != "" {
+				t.Errorf("expected no warning, got %q", warning)
+			}
+			for _, want := range test.wantWarning {
+				if !strings.Contains(warning, want) {
+					t.Errorf("expected warning to contain %q, got %q", want, warning)
+				}
+			}
+			for _, notWant := range test.notWarning {
+				if strings.Contains(warning, notWant) {
+					t.Errorf("expected warning not to contain %q, got %q", notWant, warning)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &CatalogExclusionResource{}
+	_ resource.ResourceWithConfigure   = &CatalogExclusionResource{}
+	_ resource.ResourceWithImportState = &CatalogExclusionResource{}
+)
+
+// NewCatalogExclusionResource is a helper function to simplify the provider implementation.
+func NewCatalogExclusionResource() resource.Resource {
+	return &CatalogExclusionResource{}
+}
+
+// CatalogExclusionResource authoritatively manages merchants, offers and categories hidden from the configured app.
+type CatalogExclusionResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *CatalogExclusionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_exclusion"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CatalogExclusionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type CatalogExclusionResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	MerchantIds types.Set    `tfsdk:"merchant_ids"`
+	OfferIds    types.Set    `tfsdk:"offer_ids"`
+	Categories  types.Set    `tfsdk:"categories"`
+}
+
+// newCatalogExclusionResourceModel builds resource state from exclusions returned by Violet.
+// Nothing excluded is kept null when it is null in the given model, so it does not show up as a diff.
+func newCatalogExclusionResourceModel(ctx context.Context, appId string, exclusions violet.VioletCatalogExclusions, from CatalogExclusionResourceModel) (CatalogExclusionResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	merchantIds, d := optionalSetValue(ctx, types.Int64Type, exclusions.MerchantIds, from.MerchantIds)
+	diags.Append(d...)
+	offerIds, d := optionalSetValue(ctx, types.Int64Type, exclusions.OfferIds, from.OfferIds)
+	diags.Append(d...)
+	categories, d := optionalSetValue(ctx, types.StringType, exclusions.Categories, from.Categories)
+	diags.Append(d...)
+
+	return CatalogExclusionResourceModel{
+		Id:          types.StringValue(appId),
+		MerchantIds: merchantIds,
+		OfferIds:    offerIds,
+		Categories:  categories,
+	}, diags
+}
+
+// optionalSetValue returns null for no elements when the attribute is null in prior model.
+func optionalSetValue[T any](ctx context.Context, elementType attr.Type, elements []T, from types.Set) (types.Set, diag.Diagnostics) {
+	if len(elements) == 0 {
+		if from.IsNull() {
+			return types.SetNull(elementType), nil
+		}
+		elements = []T{}
+	}
+
+	return types.SetValueFrom(ctx, elementType, elements)
+}
+
+// Schema defines the schema for the resource.
+func (r *CatalogExclusionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to authoritatively manage merchants, offers and categories of Violet catalogue hidden from the app. " +
+			"Exclusions that are not in the configuration are removed on apply, and destroying the resource removes all exclusions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "App Id the exclusions belong to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"merchant_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Ids of merchants whose offers are hidden",
+			},
+			"offer_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Ids of hidden offers",
+			},
+			"categories": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Categories whose offers are hidden",
+			},
+		},
+	}
+}
+
+// ImportState imports exclusions of the configured app, the import id is ignored.
+func (r *CatalogExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.client.AppId)...)
+}
+
+// Create replaces exclusions of the app with the configured ones.
+func (r *CatalogExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CatalogExclusionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CatalogExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState CatalogExclusionResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read catalog exclusion resource")
+
+	err, exclusions := r.client.GetCatalogExclusions(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Violet catalog exclusions",
+			"Get catalog exclusions failed: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags := newCatalogExclusionResourceModel(ctx, r.client.AppId, exclusions, oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *CatalogExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CatalogExclusionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes all exclusions of the app.
+func (r *CatalogExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	err, _ := r.client.UpdateCatalogExclusions(ctx, violet.VioletCatalogExclusions{
+		MerchantIds: []int64{},
+		OfferIds:    []int64{},
+		Categories:  []string{},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Violet catalog exclusions",
+			"Update catalog exclusions failed: "+err.Error(),
+		)
+	}
+}
+
+// apply saves the planned exclusions in Violet and writes the result to state.
+func (r *CatalogExclusionResource) apply(ctx context.Context, plan CatalogExclusionResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	exclusions := violet.VioletCatalogExclusions{
+		MerchantIds: []int64{},
+		OfferIds:    []int64{},
+		Categories:  []string{},
+	}
+
+	if !plan.MerchantIds.IsNull() {
+		diags.Append(plan.MerchantIds.ElementsAs(ctx, &exclusions.MerchantIds, false)...)
+	}
+	if !plan.OfferIds.IsNull() {
+		diags.Append(plan.OfferIds.ElementsAs(ctx, &exclusions.OfferIds, false)...)
+	}
+	if !plan.Categories.IsNull() {
+		diags.Append(plan.Categories.ElementsAs(ctx, &exclusions.Categories, false)...)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Apply catalog exclusions", map[string]interface{}{
+		"merchant_ids": exclusions.MerchantIds,
+		"offer_ids":    exclusions.OfferIds,
+		"categories":   exclusions.Categories,
+	})
+
+	err, exclusions := r.client.UpdateCatalogExclusions(ctx, exclusions)
+
+	if err != nil {
+		diags.AddError(
+			"Error updating Violet catalog exclusions",
+			"Update catalog exclusions failed: "+err.Error(),
+		)
+		return
+	}
+
+	model, d := newCatalogExclusionResourceModel(ctx, r.client.AppId, exclusions, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(state.Set(ctx, model)...)
+}
@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &CollectionResource{}
+	_ resource.ResourceWithConfigure      = &CollectionResource{}
+	_ resource.ResourceWithImportState    = &CollectionResource{}
+	_ resource.ResourceWithValidateConfig = &CollectionResource{}
+)
+
+// NewCollectionResource is a helper function to simplify the provider implementation.
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResource manages a curated collection of offers.
+type CollectionResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *CollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type CollectionResourceModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	ImageUrl         types.String `tfsdk:"image_url"`
+	OfferIds         types.List   `tfsdk:"offer_ids"`
+	DateCreated      types.String `tfsdk:"date_created"`
+	DateLastModified types.String `tfsdk:"date_last_modified"`
+}
+
+// newCollectionResourceModel builds resource state from the collection returned by Violet.
+// Empty optional attributes stay null when they are null in the given model, so they do not show up as a diff.
+func newCollectionResourceModel(ctx context.Context, collection violet.VioletCollection, offerIds []int64, from CollectionResourceModel) (CollectionResourceModel, diag.Diagnostics) {
+	offers, diags := types.ListValueFrom(ctx, types.Int64Type, offerIds)
+	if len(offerIds) == 0 && from.OfferIds.IsNull() {
+		offers = types.ListNull(types.Int64Type)
+	}
+
+	return CollectionResourceModel{
+		Id:               types.Int64Value(collection.Id),
+		Name:             types.StringValue(collection.Name),
+		Description:      optionalStringValue(collection.Description, from.Description),
+		ImageUrl:         optionalStringValue(collection.ImageUrl, from.ImageUrl),
+		OfferIds:         offers,
+		DateCreated:      types.StringValue(collection.DateCreated),
+		DateLastModified: types.StringValue(collection.DateLastModified),
+	}, diags
+}
+
+// optionalStringValue returns null for an empty value when the attribute is null in prior model.
+func optionalStringValue(value string, from types.String) types.String {
+	if value == "" && from.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// Schema defines the schema for the resource.
+func (r *CollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage a curated collection of offers of Violet catalogue",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Collection id",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of collection",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of collection",
+			},
+			"image_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of image of collection",
+			},
+			"offer_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Ids of offers in the collection, in the order they are shown",
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Creation date of collection",
+			},
+			"date_last_modified": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of last modification of collection",
+			},
+		},
+	}
+}
+
+func (r *CollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CollectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.OfferIds.IsNull() || config.OfferIds.IsUnknown() {
+		return
+	}
+
+	var offerIds []types.Int64
+	resp.Diagnostics.Append(config.OfferIds.ElementsAs(ctx, &offerIds, false)...)
+
+	seen := map[int64]bool{}
+	for i, offerId := range offerIds {
+		if offerId.IsUnknown() || offerId.IsNull() {
+			continue
+		}
+
+		if seen[offerId.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("offer_ids").AtListIndex(i),
+				"Duplicate offer id",
+				fmt.Sprintf("Offer id %d is in the collection more than once.", offerId.ValueInt64()),
+			)
+		}
+		seen[offerId.ValueInt64()] = true
+	}
+}
+
+// ImportState imports a collection by its numeric id.
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier to be a numeric collection id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Create creates the collection, adds its offers and sets the initial Terraform state.
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CollectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offerIds, diags := collectionOfferIds(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err, collection := r.client.CreateCollection(ctx, collectionInput(plan))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Violet collection",
+			"Create collection failed: "+err.Error(),
+		)
+		return
+	}
+
+	if len(offerIds) > 0 {
+		err = r.client.SetCollectionOffers(ctx, collection.Id, offerIds)
+
+		if err != nil {
+			// The collection exists already, so it is saved to state to not leave it orphaned. Terraform taints
+			// it because of the error, and the next apply replaces it. Offers are not in state as planned, so
+			// this can not be a warning, which would fail with an inconsistent result after apply.
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error adding offers to Violet collection id: %d", collection.Id),
+				"Set collection offers failed: "+err.Error()+"\n\nThe collection was created and is replaced on the next apply.",
+			)
+			state, diags := newCollectionResourceModel(ctx, collection, nil, plan)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+
+	r.refresh(ctx, collection.Id, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState CollectionResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := oldState.Id.ValueInt64()
+
+	tflog.Info(ctx, "Read collection resource", map[string]interface{}{
+		"id": id,
+	})
+
+	err, collection := r.client.GetCollection(ctx, id)
+
+	if violet.IsNotFound(err) {
+		tflog.Warn(ctx, "Collection no longer exists, removing it from state", map[string]interface{}{
+			"id": id,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet collection id: %d", id),
+			"Get collection failed: "+err.Error(),
+		)
+		return
+	}
+
+	err, offerIds := r.client.ListCollectionOffers(ctx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading offers of Violet collection id: %d", id),
+			"List collection offers failed: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags := newCollectionResourceModel(ctx, collection, offerIds, oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update changes the collection in place. Offers are replaced as a whole, which adds, removes and reorders them at once.
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueInt64()
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.ImageUrl.Equal(state.ImageUrl) {
+		err, _ := r.client.UpdateCollection(ctx, id, collectionInput(plan))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error updating Violet collection id: %d", id),
+				"Update collection failed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.OfferIds.Equal(state.OfferIds) {
+		offerIds, diags := collectionOfferIds(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.client.SetCollectionOffers(ctx, id, offerIds)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error updating offers of Violet collection id: %d", id),
+				"Set collection offers failed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	r.refresh(ctx, id, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CollectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueInt64()
+	err := r.client.DeleteCollection(ctx, id)
+
+	if err != nil && !violet.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting Violet collection id: %d", id),
+			"Delete collection failed: "+err.Error(),
+		)
+	}
+}
+
+// refresh reads the saved collection with its offers and writes it to state.
+func (r *CollectionResource) refresh(ctx context.Context, id int64, plan CollectionResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	err, collection := r.client.GetCollection(ctx, id)
+
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading Violet collection id: %d", id),
+			"Get collection failed: "+err.Error(),
+		)
+		return
+	}
+
+	err, offerIds := r.client.ListCollectionOffers(ctx, id)
+
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading offers of Violet collection id: %d", id),
+			"List collection offers failed: "+err.Error(),
+		)
+		return
+	}
+
+	model, d := newCollectionResourceModel(ctx, collection, offerIds, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(state.Set(ctx, model)...)
+}
+
+func collectionInput(model CollectionResourceModel) violet.CollectionInput {
+	return violet.CollectionInput{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ImageUrl:    model.ImageUrl.ValueString(),
+	}
+}
+
+func collectionOfferIds(ctx context.Context, model CollectionResourceModel) ([]int64, diag.Diagnostics) {
+	offerIds := []int64{}
+	if model.OfferIds.IsNull() {
+		return offerIds, nil
+	}
+
+	diags := model.OfferIds.ElementsAs(ctx, &offerIds, false)
+
+	return offerIds, diags
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &CommissionRateResource{}
+	_ resource.ResourceWithConfigure      = &CommissionRateResource{}
+	_ resource.ResourceWithImportState    = &CommissionRateResource{}
+	_ resource.ResourceWithValidateConfig = &CommissionRateResource{}
+)
+
+const (
+	minCommissionRate = 0
+	maxCommissionRate = 100
+)
+
+// NewCommissionRateResource is a helper function to simplify the provider implementation.
+func NewCommissionRateResource() resource.Resource {
+	return &CommissionRateResource{}
+}
+
+// CommissionRateResource manages the commission rate of the configured app for a single merchant.
+type CommissionRateResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *CommissionRateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commission_rate"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CommissionRateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type CommissionRateResourceModel struct {
+	Id               types.String  `tfsdk:"id"`
+	MerchantId       types.Int64   `tfsdk:"merchant_id"`
+	CommissionRate   types.Float64 `tfsdk:"commission_rate"`
+	DateLastModified types.String  `tfsdk:"date_last_modified"`
+}
+
+func newCommissionRateResourceModel(rate violet.VioletCommissionRate, merchantId int64) CommissionRateResourceModel {
+	return CommissionRateResourceModel{
+		Id:               types.StringValue(strconv.FormatInt(merchantId, 10)),
+		MerchantId:       types.Int64Value(merchantId),
+		CommissionRate:   types.Float64Value(rate.CommissionRate),
+		DateLastModified: types.StringValue(rate.DateLastModified),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *CommissionRateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage commission rate of the Violet app for a merchant. " +
+			"Destroying the resource leaves the last commission rate in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Merchant id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"merchant_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Id of the merchant the commission rate applies to",
+			},
+			"commission_rate": schema.Float64Attribute{
+				Required:    true,
+				Description: fmt.Sprintf("Commission rate in percent, from %d to %d", minCommissionRate, maxCommissionRate),
+			},
+			"date_last_modified": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of last modification of the commission rate",
+			},
+		},
+	}
+}
+
+func (r *CommissionRateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CommissionRateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateCommissionRate(config.CommissionRate, path.Root("commission_rate"), resp)
+}
+
+// validateCommissionRate reports an error when a known rate is outside of the allowed percentage range.
+func validateCommissionRate(rate types.Float64, attributePath path.Path, resp *resource.ValidateConfigResponse) {
+	if rate.IsNull() || rate.IsUnknown() {
+		return
+	}
+
+	if value := rate.ValueFloat64(); value < minCommissionRate || value > maxCommissionRate {
+		resp.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid commission rate",
+			fmt.Sprintf("Expected percentage from %d to %d, got: %g", minCommissionRate, maxCommissionRate, value),
+		)
+	}
+}
+
+// ImportState imports the commission rate by merchant id.
+func (r *CommissionRateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	merchantId, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier to be a numeric merchant id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("merchant_id"), merchantId)...)
+}
+
+// Create sets the commission rate and sets the initial Terraform state.
+func (r *CommissionRateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CommissionRateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CommissionRateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState CommissionRateResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merchantId := oldState.MerchantId.ValueInt64()
+
+	tflog.Info(ctx, "Read commission rate resource", map[string]interface{}{
+		"merchant_id": merchantId,
+	})
+
+	err, rate := r.client.GetCommissionRate(ctx, merchantId)
+
+	if violet.IsNotFound(err) {
+		tflog.Warn(ctx, "Merchant is no longer connected to the app, removing commission rate from state", map[string]interface{}{
+			"merchant_id": merchantId,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet commission rate of merchant id: %d", merchantId),
+			"Get commission rate failed: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newCommissionRateResourceModel(rate, merchantId))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *CommissionRateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CommissionRateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the commission rate from the Terraform state, the rate stays in Violet as it is.
+func (r *CommissionRateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// set saves the planned commission rate in Violet and writes the result to state.
+func (r *CommissionRateResource) set(ctx context.Context, plan CommissionRateResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	merchantId := plan.MerchantId.ValueInt64()
+
+	err, rate := r.client.SetCommissionRate(ctx, merchantId, plan.CommissionRate.ValueFloat64())
+
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error setting Violet commission rate of merchant id: %d", merchantId),
+			"Set commission rate failed: "+err.Error(),
+		)
+		return
+	}
+
+	diags.Append(state.Set(ctx, newCommissionRateResourceModel(rate, merchantId))...)
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &GlobalCommissionResource{}
+	_ resource.ResourceWithConfigure      = &GlobalCommissionResource{}
+	_ resource.ResourceWithImportState    = &GlobalCommissionResource{}
+	_ resource.ResourceWithValidateConfig = &GlobalCommissionResource{}
+)
+
+// NewGlobalCommissionResource is a helper function to simplify the provider implementation.
+func NewGlobalCommissionResource() resource.Resource {
+	return &GlobalCommissionResource{}
+}
+
+// GlobalCommissionResource manages the default commission of the configured app. There is exactly one per app.
+type GlobalCommissionResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *GlobalCommissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_commission"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *GlobalCommissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type GlobalCommissionResourceModel struct {
+	Id               types.String  `tfsdk:"id"`
+	CommissionRate   types.Float64 `tfsdk:"commission_rate"`
+	Locked           types.Bool    `tfsdk:"locked"`
+	DateLastModified types.String  `tfsdk:"date_last_modified"`
+}
+
+func newGlobalCommissionResourceModel(commission violet.VioletGlobalCommission, appId string) GlobalCommissionResourceModel {
+	return GlobalCommissionResourceModel{
+		Id:               types.StringValue(appId),
+		CommissionRate:   types.Float64Value(commission.CommissionRate),
+		Locked:           types.BoolValue(commission.Locked),
+		DateLastModified: types.StringValue(commission.DateLastModified),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *GlobalCommissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage default commission rate of the Violet app and whether merchants can override it. " +
+			"Destroying the resource leaves the last commission in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "App Id the commission belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commission_rate": schema.Float64Attribute{
+				Required:    true,
+				Description: fmt.Sprintf("Default commission rate in percent, from %d to %d", minCommissionRate, maxCommissionRate),
+			},
+			"locked": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If enabled, merchants cannot override the default commission rate with their own",
+			},
+			"date_last_modified": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of last modification of the commission",
+			},
+		},
+	}
+}
+
+func (r *GlobalCommissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GlobalCommissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateCommissionRate(config.CommissionRate, path.Root("commission_rate"), resp)
+}
+
+// ImportState imports commission of the configured app, the import id is ignored.
+func (r *GlobalCommissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.client.AppId)...)
+}
+
+// Create sets the commission and sets the initial Terraform state.
+func (r *GlobalCommissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GlobalCommissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *GlobalCommissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read global commission resource")
+
+	err, commission := r.client.GetGlobalCommission(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Violet global commission",
+			"Get global commission failed: "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, newGlobalCommissionResourceModel(commission, r.client.AppId))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *GlobalCommissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GlobalCommissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the commission from the Terraform state, it stays in Violet as it is.
+func (r *GlobalCommissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// set saves the planned commission in Violet and writes the result to state.
+func (r *GlobalCommissionResource) set(ctx context.Context, plan GlobalCommissionResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	err, commission := r.client.UpdateGlobalCommission(ctx, violet.VioletGlobalCommission{
+		CommissionRate: plan.CommissionRate.ValueFloat64(),
+		Locked:         plan.Locked.ValueBool(),
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error updating Violet global commission",
+			"Update global commission failed: "+err.Error(),
+		)
+		return
+	}
+
+	diags.Append(state.Set(ctx, newGlobalCommissionResourceModel(commission, r.client.AppId))...)
+}
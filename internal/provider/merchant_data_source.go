@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource                   = &merchantDataSource{}
+	_ datasource.DataSourceWithConfigure      = &merchantDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &merchantDataSource{}
+)
+
+func MerchantDataSource() datasource.DataSource {
+	return &merchantDataSource{}
+}
+
+type merchantDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *merchantDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *merchantDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_merchant"
+}
+
+type merchantModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	StoreUrl         types.String `tfsdk:"store_url"`
+	Platform         types.String `tfsdk:"platform"`
+	Status           types.String `tfsdk:"status"`
+	ConnectionStatus types.String `tfsdk:"connection_status"`
+	Currency         types.String `tfsdk:"currency"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	DateCreated      types.String `tfsdk:"date_created"`
+}
+
+func newMerchantModel(merchant violet.VioletMerchant) merchantModel {
+	return merchantModel{
+		Id:               types.Int64Value(merchant.Id),
+		Name:             types.StringValue(merchant.Name),
+		StoreUrl:         types.StringValue(merchant.StoreUrl),
+		Platform:         types.StringValue(merchant.Platform),
+		Status:           types.StringValue(merchant.Status),
+		ConnectionStatus: types.StringValue(merchant.ConnectionStatus),
+		Currency:         types.StringValue(merchant.Currency),
+		CountryCode:      types.StringValue(merchant.CountryCode),
+		DateCreated:      types.StringValue(merchant.DateCreated),
+	}
+}
+
+func (d *merchantDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get data of a merchant connected to Violet app, looked up by id, name or store URL",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Merchant id",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of merchant, matched case-insensitively",
+			},
+			"store_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "URL of merchant store, matched ignoring scheme and trailing slash",
+			},
+			"platform": schema.StringAttribute{
+				Computed:    true,
+				Description: "Commerce platform of merchant, e.g. SHOPIFY or BIGCOMMERCE",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of merchant",
+			},
+			"connection_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of connection between merchant and app",
+			},
+			"currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Default currency of merchant",
+			},
+			"country_code": schema.StringAttribute{
+				Computed:    true,
+				Description: "Country of merchant",
+			},
+			"date_created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date of merchant",
+			},
+		},
+	}
+}
+
+func (d *merchantDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config merchantModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := 0
+	if !config.Id.IsNull() {
+		set++
+	}
+	if !config.Name.IsNull() {
+		set++
+	}
+	if !config.StoreUrl.IsNull() {
+		set++
+	}
+
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid merchant lookup",
+			"Exactly one of id, name or store_url has to be set.",
+		)
+	}
+}
+
+func (d *merchantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data merchantModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read merchantDataSource", map[string]interface{}{
+		"id":        data.Id.ValueInt64(),
+		"name":      data.Name.ValueString(),
+		"store_url": data.StoreUrl.ValueString(),
+	})
+
+	if !data.Id.IsNull() {
+		id := data.Id.ValueInt64()
+		err, merchant := d.client.GetMerchant(ctx, id)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading Violet merchant id: %d", id),
+				"error getting merchant: "+err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, newMerchantModel(merchant))...)
+		return
+	}
+
+	err, merchants := d.client.ListMerchants(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Violet merchants",
+			"error listing merchants: "+err.Error(),
+		)
+		return
+	}
+
+	var found []violet.VioletMerchant
+	for _, merchant := range merchants {
+		if !data.Name.IsNull() && strings.EqualFold(merchant.Name, data.Name.ValueString()) ||
+			!data.StoreUrl.IsNull() && normalizeStoreUrl(merchant.StoreUrl) == normalizeStoreUrl(data.StoreUrl.ValueString()) {
+			found = append(found, merchant)
+		}
+	}
+
+	lookup := fmt.Sprintf("name %q", data.Name.ValueString())
+	if !data.StoreUrl.IsNull() {
+		lookup = fmt.Sprintf("store URL %q", data.StoreUrl.ValueString())
+	}
+
+	switch len(found) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Violet merchant not found",
+			fmt.Sprintf("No merchant with %s is connected to the app.", lookup),
+		)
+		return
+	case 1:
+	default:
+		ids := make([]string, 0, len(found))
+		for _, merchant := range found {
+			ids = append(ids, fmt.Sprintf("%d", merchant.Id))
+		}
+
+		resp.Diagnostics.AddError(
+			"Multiple Violet merchants found",
+			fmt.Sprintf("Merchants with ids %s match %s, use id to select one.", strings.Join(ids, ", "), lookup),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newMerchantModel(found[0]))...)
+}
+
+// normalizeStoreUrl drops scheme, trailing slash and case, so e.g. https://Store.com/ matches store.com.
+func normalizeStoreUrl(url string) string {
+	url = strings.ToLower(strings.TrimSpace(url))
+	url = strings.TrimPrefix(url, "https://")
+	url = strings.TrimPrefix(url, "http://")
+
+	return strings.TrimSuffix(url, "/")
+}
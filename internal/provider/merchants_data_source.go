@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &merchantsDataSource{}
+	_ datasource.DataSourceWithConfigure = &merchantsDataSource{}
+)
+
+func MerchantsDataSource() datasource.DataSource {
+	return &merchantsDataSource{}
+}
+
+type merchantsDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *merchantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *merchantsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_merchants"
+}
+
+type merchantsModel struct {
+	Status    types.String    `tfsdk:"status"`
+	Platform  types.String    `tfsdk:"platform"`
+	Country   types.String    `tfsdk:"country"`
+	Ids       []types.Int64   `tfsdk:"ids"`
+	Merchants []merchantModel `tfsdk:"merchants"`
+}
+
+func (d *merchantsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to list merchants connected to Violet app",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return merchants with the status, matched case-insensitively",
+			},
+			"platform": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return merchants on the commerce platform, e.g. SHOPIFY or BIGCOMMERCE, matched case-insensitively",
+			},
+			"country": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return merchants from the country, e.g. US, matched case-insensitively",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Ids of returned merchants",
+			},
+			"merchants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Merchants matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Merchant id",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of merchant",
+						},
+						"store_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of merchant store",
+						},
+						"platform": schema.StringAttribute{
+							Computed:    true,
+							Description: "Commerce platform of merchant",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of merchant",
+						},
+						"connection_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of connection between merchant and app",
+						},
+						"currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Default currency of merchant",
+						},
+						"country_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "Country of merchant",
+						},
+						"date_created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date of merchant",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *merchantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data merchantsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read merchantsDataSource", map[string]interface{}{
+		"status":   data.Status.ValueString(),
+		"platform": data.Platform.ValueString(),
+		"country":  data.Country.ValueString(),
+	})
+
+	err, merchants := d.client.ListMerchants(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Violet merchants",
+			"error listing merchants: "+err.Error(),
+		)
+		return
+	}
+
+	data.Ids = []types.Int64{}
+	data.Merchants = []merchantModel{}
+
+	for _, merchant := range merchants {
+		if !matchesFilter(data.Status, merchant.Status) ||
+			!matchesFilter(data.Platform, merchant.Platform) ||
+			!matchesFilter(data.Country, merchant.CountryCode) {
+			continue
+		}
+
+		data.Ids = append(data.Ids, types.Int64Value(merchant.Id))
+		data.Merchants = append(data.Merchants, newMerchantModel(merchant))
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// matchesFilter reports whether value is equal to the optional filter ignoring case. Unset filter matches everything.
+func matchesFilter(filter types.String, value string) bool {
+	return filter.IsNull() || strings.EqualFold(filter.ValueString(), value)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &offerDataSource{}
+	_ datasource.DataSourceWithConfigure = &offerDataSource{}
+)
+
+func OfferDataSource() datasource.DataSource {
+	return &offerDataSource{}
+}
+
+type offerDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *offerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *offerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_offer"
+}
+
+type offerModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	ProductId   types.String `tfsdk:"product_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MerchantId  types.Int64  `tfsdk:"merchant_id"`
+	MinPrice    types.Int64  `tfsdk:"min_price"`
+	MaxPrice    types.Int64  `tfsdk:"max_price"`
+	Currency    types.String `tfsdk:"currency"`
+	Status      types.String `tfsdk:"status"`
+	Available   types.Bool   `tfsdk:"available"`
+	Skus        []skuModel   `tfsdk:"skus"`
+}
+
+type skuModel struct {
+	Id                types.Int64  `tfsdk:"id"`
+	OfferId           types.Int64  `tfsdk:"offer_id"`
+	Name              types.String `tfsdk:"name"`
+	RetailPrice       types.Int64  `tfsdk:"retail_price"`
+	SalePrice         types.Int64  `tfsdk:"sale_price"`
+	Currency          types.String `tfsdk:"currency"`
+	InStock           types.Bool   `tfsdk:"in_stock"`
+	QuantityAvailable types.Int64  `tfsdk:"quantity_available"`
+	VariantAttributes types.Map    `tfsdk:"variant_attributes"`
+}
+
+// skusAttribute is the schema of SKUs, shared by offer and product data sources.
+func skusAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:    true,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Computed:    true,
+					Description: "SKU id",
+				},
+				"offer_id": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Id of the offer the SKU belongs to",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "Name of SKU",
+				},
+				"retail_price": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Regular price of SKU",
+				},
+				"sale_price": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Price SKU is currently sold for",
+				},
+				"currency": schema.StringAttribute{
+					Computed:    true,
+					Description: "Currency of prices",
+				},
+				"in_stock": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether SKU is in stock",
+				},
+				"quantity_available": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Number of items in stock",
+				},
+				"variant_attributes": schema.MapAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+					Description: "Values of variant attributes of SKU by attribute name, e.g. Size = 10",
+				},
+			},
+		},
+	}
+}
+
+func newSkuModels(ctx context.Context, skus []violet.VioletSku) ([]skuModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := []skuModel{}
+
+	for _, sku := range skus {
+		variants := map[string]string{}
+		for _, value := range sku.VariantValues {
+			variants[value.Variant] = value.Value
+		}
+
+		variantAttributes, d := types.MapValueFrom(ctx, types.StringType, variants)
+		diags.Append(d...)
+
+		models = append(models, skuModel{
+			Id:                types.Int64Value(sku.Id),
+			OfferId:           types.Int64Value(sku.OfferId),
+			Name:              types.StringValue(sku.Name),
+			RetailPrice:       types.Int64Value(sku.RetailPrice),
+			SalePrice:         types.Int64Value(sku.SalePrice),
+			Currency:          types.StringValue(sku.Currency),
+			InStock:           types.BoolValue(sku.InStock),
+			QuantityAvailable: types.Int64Value(sku.QuantityAvailable),
+			VariantAttributes: variantAttributes,
+		})
+	}
+
+	return models, diags
+}
+
+func (d *offerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get an offer of Violet catalogue with its SKUs. Prices are in minor units of the currency, e.g. cents",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Offer id",
+			},
+			"product_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Id of the product the offer belongs to",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of offer",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of offer",
+			},
+			"merchant_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the merchant selling the offer",
+			},
+			"min_price": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Lowest price of SKUs of the offer",
+			},
+			"max_price": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Highest price of SKUs of the offer",
+			},
+			"currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency of prices",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of offer",
+			},
+			"available": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the offer is available for purchase",
+			},
+			"skus": skusAttribute("SKUs of the offer"),
+		},
+	}
+}
+
+func (d *offerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data offerModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	tflog.Info(ctx, "Read offerDataSource", map[string]interface{}{
+		"id": id,
+	})
+
+	err, offer := d.client.GetOffer(ctx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet offer id: %d", id),
+			"error getting offer: "+err.Error(),
+		)
+		return
+	}
+
+	skus, diags := newSkuModels(ctx, offer.Skus)
+	resp.Diagnostics.Append(diags...)
+
+	state := offerModel{
+		Id:          types.Int64Value(offer.Id),
+		ProductId:   types.StringValue(offer.ProductId),
+		Name:        types.StringValue(offer.Name),
+		Description: types.StringValue(offer.Description),
+		MerchantId:  types.Int64Value(offer.MerchantId),
+		MinPrice:    types.Int64Value(offer.MinPrice),
+		MaxPrice:    types.Int64Value(offer.MaxPrice),
+		Currency:    types.StringValue(offer.Currency),
+		Status:      types.StringValue(offer.Status),
+		Available:   types.BoolValue(offer.Available),
+		Skus:        skus,
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
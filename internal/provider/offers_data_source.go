@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &offersDataSource{}
+	_ datasource.DataSourceWithConfigure = &offersDataSource{}
+)
+
+const defaultOffersLimit = 100
+
+func OffersDataSource() datasource.DataSource {
+	return &offersDataSource{}
+}
+
+type offersDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *offersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *offersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_offers"
+}
+
+type offersModel struct {
+	MerchantId types.Int64         `tfsdk:"merchant_id"`
+	Query      types.String        `tfsdk:"query"`
+	Category   types.String        `tfsdk:"category"`
+	Available  types.Bool          `tfsdk:"available"`
+	MinPrice   types.Int64         `tfsdk:"min_price"`
+	MaxPrice   types.Int64         `tfsdk:"max_price"`
+	Limit      types.Int64         `tfsdk:"limit"`
+	Ids        []types.Int64       `tfsdk:"ids"`
+	Offers     []offerSummaryModel `tfsdk:"offers"`
+}
+
+type offerSummaryModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	MerchantId types.Int64  `tfsdk:"merchant_id"`
+	MinPrice   types.Int64  `tfsdk:"min_price"`
+	MaxPrice   types.Int64  `tfsdk:"max_price"`
+	Currency   types.String `tfsdk:"currency"`
+	Status     types.String `tfsdk:"status"`
+	Available  types.Bool   `tfsdk:"available"`
+}
+
+func (d *offersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to search offers of Violet catalogue. Prices are in minor units of the currency, e.g. cents",
+		Attributes: map[string]schema.Attribute{
+			"merchant_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return offers of the merchant",
+			},
+			"query": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text the offers are searched by",
+			},
+			"category": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return offers in the category",
+			},
+			"available": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return offers that are, or are not, available for purchase",
+			},
+			"min_price": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return offers with price at least this",
+			},
+			"max_price": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return offers with price at most this",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of offers returned, 0 returns all matching offers. Defaults to %d", defaultOffersLimit),
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Ids of returned offers",
+			},
+			"offers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Offers matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Offer id",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of offer",
+						},
+						"merchant_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Id of the merchant selling the offer",
+						},
+						"min_price": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Lowest price of SKUs of the offer",
+						},
+						"max_price": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Highest price of SKUs of the offer",
+						},
+						"currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency of prices",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of offer",
+						},
+						"available": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the offer is available for purchase",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *offersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data offersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := violet.SearchOffersInput{
+		MerchantId: data.MerchantId.ValueInt64(),
+		Query:      data.Query.ValueString(),
+		Category:   data.Category.ValueString(),
+		MinPrice:   data.MinPrice.ValueInt64(),
+		MaxPrice:   data.MaxPrice.ValueInt64(),
+		Limit:      defaultOffersLimit,
+	}
+
+	if !data.Available.IsNull() {
+		available := data.Available.ValueBool()
+		input.Available = &available
+	}
+
+	if !data.Limit.IsNull() {
+		input.Limit = int(data.Limit.ValueInt64())
+	}
+
+	if input.Limit < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid limit",
+			fmt.Sprintf("Expected limit of 0 or more, got: %d", input.Limit),
+		)
+	}
+
+	if input.MinPrice < 0 || input.MaxPrice < 0 || input.MaxPrice > 0 && input.MinPrice > input.MaxPrice {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_price"),
+			"Invalid price range",
+			fmt.Sprintf("Expected non-negative prices with min_price not above max_price, got: %d to %d", input.MinPrice, input.MaxPrice),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read offersDataSource", map[string]interface{}{
+		"merchant_id": input.MerchantId,
+		"query":       input.Query,
+		"category":    input.Category,
+	})
+
+	err, offers := d.client.SearchOffers(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error searching Violet offers",
+			"error searching offers: "+err.Error(),
+		)
+		return
+	}
+
+	data.Ids = []types.Int64{}
+	data.Offers = []offerSummaryModel{}
+
+	for _, offer := range offers {
+		data.Ids = append(data.Ids, types.Int64Value(offer.Id))
+		data.Offers = append(data.Offers, offerSummaryModel{
+			Id:         types.Int64Value(offer.Id),
+			Name:       types.StringValue(offer.Name),
+			MerchantId: types.Int64Value(offer.MerchantId),
+			MinPrice:   types.Int64Value(offer.MinPrice),
+			MaxPrice:   types.Int64Value(offer.MaxPrice),
+			Currency:   types.StringValue(offer.Currency),
+			Status:     types.StringValue(offer.Status),
+			Available:  types.BoolValue(offer.Available),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
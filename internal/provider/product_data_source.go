@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &productDataSource{}
+	_ datasource.DataSourceWithConfigure = &productDataSource{}
+)
+
+func ProductDataSource() datasource.DataSource {
+	return &productDataSource{}
+}
+
+type productDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *productDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *productDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_product"
+}
+
+type productModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Brand       types.String  `tfsdk:"brand"`
+	OfferIds    []types.Int64 `tfsdk:"offer_ids"`
+	Skus        []skuModel    `tfsdk:"skus"`
+}
+
+func (d *productDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get a product of Violet catalogue with SKUs of all its offers. Prices are in minor units of the currency, e.g. cents",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Product id",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of product",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of product",
+			},
+			"brand": schema.StringAttribute{
+				Computed:    true,
+				Description: "Brand of product",
+			},
+			"offer_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Ids of offers of the product, one per merchant selling it",
+			},
+			"skus": skusAttribute("SKUs of all offers of the product"),
+		},
+	}
+}
+
+func (d *productDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data productModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueString()
+
+	tflog.Info(ctx, "Read productDataSource", map[string]interface{}{
+		"id": id,
+	})
+
+	err, product := d.client.GetProduct(ctx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet product id: %s", id),
+			"error getting product: "+err.Error(),
+		)
+		return
+	}
+
+	offerIds := []types.Int64{}
+	var skus []violet.VioletSku
+
+	for _, offer := range product.Offers {
+		offerIds = append(offerIds, types.Int64Value(offer.Id))
+		skus = append(skus, offer.Skus...)
+	}
+
+	skuModels, diags := newSkuModels(ctx, skus)
+	resp.Diagnostics.Append(diags...)
+
+	state := productModel{
+		Id:          types.StringValue(product.Id),
+		Name:        types.StringValue(product.Name),
+		Description: types.StringValue(product.Description),
+		Brand:       types.StringValue(product.Brand),
+		OfferIds:    offerIds,
+		Skus:        skuModels,
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
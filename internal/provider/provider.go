@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +17,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &violetProvider{}
+	_ provider.Provider              = &violetProvider{}
+	_ provider.ProviderWithFunctions = &violetProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -48,6 +50,14 @@ type violetProviderModel struct {
 	AppId     types.String `tfsdk:"app_id"`
 	AppSecret types.String `tfsdk:"app_secret"`
 	Sandbox   types.Bool   `tfsdk:"sandbox"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// violetProviderData is passed to resources, so they have access to provider-wide defaults next to the client.
+type violetProviderData struct {
+	Client             *violet.VioletClient
+	DeletionProtection bool
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -77,6 +87,10 @@ func (p *violetProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Use Violet sandbox environment",
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Default value of deletion_protection for violet_webhook resources",
+			},
 		},
 	}
 }
@@ -210,6 +224,7 @@ func (p *violetProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		AppId:     appId,
 		AppSecret: appSecret,
 		BaseUrl:   baseUrl,
+		Sandbox:   sandbox,
 	}
 	err := client.Login(ctx)
 
@@ -221,13 +236,26 @@ func (p *violetProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	resp.DataSourceData = &client
-	resp.ResourceData = &client
+	resp.ResourceData = &violetProviderData{
+		Client:             &client,
+		DeletionProtection: config.DeletionProtection.ValueBool(),
+	}
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *violetProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		WebhookDataSource,
+		WebhookSamplePayloadDataSource,
+		WebhookPayloadSchemaDataSource,
+		WebhookDeliveriesDataSource,
+		WebhookHealthDataSource,
+		AppDataSource,
+		MerchantDataSource,
+		MerchantsDataSource,
+		OffersDataSource,
+		OfferDataSource,
+		ProductDataSource,
 	}
 }
 
@@ -235,5 +263,22 @@ func (p *violetProvider) DataSources(_ context.Context) []func() datasource.Data
 func (p *violetProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWebhookResource,
+		NewWebhookSubscriptionResource,
+		NewAppWebhooksResource,
+		NewWebhookTestResource,
+		NewAppSettingsResource,
+		NewAppSecretResource,
+		NewCommissionRateResource,
+		NewGlobalCommissionResource,
+		NewCollectionResource,
+		NewCatalogExclusionResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *violetProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewWebhookSignatureFunction,
+		NewVerifyWebhookSignatureFunction,
 	}
 }
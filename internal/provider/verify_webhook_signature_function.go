@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/rutkowskib/terraform-provider-violet/webhook"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &verifyWebhookSignatureFunction{}
+)
+
+// NewVerifyWebhookSignatureFunction is a helper function to simplify the provider implementation.
+func NewVerifyWebhookSignatureFunction() function.Function {
+	return &verifyWebhookSignatureFunction{}
+}
+
+type verifyWebhookSignatureFunction struct{}
+
+// Metadata returns the function name.
+func (f *verifyWebhookSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_webhook_signature"
+}
+
+// Definition defines the function parameters and return type.
+func (f *verifyWebhookSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Verify Violet webhook signature",
+		Description: "Returns true when the value of the " + webhook.HeaderHmac + " header is a valid signature of the webhook delivery body for the app secret.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "body",
+				Description: "Body of the webhook delivery",
+			},
+			function.StringParameter{
+				Name:        "secret",
+				Description: "Violet App Secret",
+			},
+			function.StringParameter{
+				Name:        "header",
+				Description: "Value of the " + webhook.HeaderHmac + " header",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run verifies the signature.
+func (f *verifyWebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var body, secret, header string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &body, &secret, &header))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, webhook.VerifySignature([]byte(body), secret, header)))
+}
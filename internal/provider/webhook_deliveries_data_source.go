@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &webhookDeliveriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookDeliveriesDataSource{}
+)
+
+const (
+	deliveryOutcomeSuccess = "success"
+	deliveryOutcomeFailure = "failure"
+
+	defaultDeliveriesLimit = 100
+)
+
+func WebhookDeliveriesDataSource() datasource.DataSource {
+	return &webhookDeliveriesDataSource{}
+}
+
+type webhookDeliveriesDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *webhookDeliveriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *webhookDeliveriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_webhook_deliveries"
+}
+
+type webhookDeliveriesModel struct {
+	WebhookId  types.Int64            `tfsdk:"webhook_id"`
+	Since      types.String           `tfsdk:"since"`
+	Until      types.String           `tfsdk:"until"`
+	Outcome    types.String           `tfsdk:"outcome"`
+	Limit      types.Int64            `tfsdk:"limit"`
+	Deliveries []webhookDeliveryModel `tfsdk:"deliveries"`
+}
+
+type webhookDeliveryModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	EventId         types.String `tfsdk:"event_id"`
+	Event           types.String `tfsdk:"event"`
+	StatusCode      types.Int64  `tfsdk:"status_code"`
+	Attempts        types.Int64  `tfsdk:"attempts"`
+	Success         types.Bool   `tfsdk:"success"`
+	ErrorMessage    types.String `tfsdk:"error_message"`
+	DateCreated     types.String `tfsdk:"date_created"`
+	DateLastAttempt types.String `tfsdk:"date_last_attempt"`
+}
+
+func (d *webhookDeliveriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get recent deliveries of Violet webhook",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Webhook id",
+			},
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC 3339 time, only deliveries created at or after it are returned",
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC 3339 time, only deliveries created before it are returned",
+			},
+			"outcome": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deliveries with the outcome, success or failure",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of most recent deliveries returned, 0 returns the whole delivery history. Defaults to %d", defaultDeliveriesLimit),
+			},
+			"deliveries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Deliveries, most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Delivery id",
+						},
+						"event_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Id of the delivered event",
+						},
+						"event": schema.StringAttribute{
+							Computed:    true,
+							Description: "Delivered event",
+						},
+						"status_code": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Status code the remote endpoint responded with on the last attempt",
+						},
+						"attempts": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of delivery attempts",
+						},
+						"success": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the remote endpoint accepted the delivery",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Error of the last attempt",
+						},
+						"date_created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date of the delivery",
+						},
+						"date_last_attempt": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date of the last delivery attempt",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *webhookDeliveriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookDeliveriesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.WebhookId.ValueInt64()
+	outcome := data.Outcome.ValueString()
+
+	if outcome != "" && outcome != deliveryOutcomeSuccess && outcome != deliveryOutcomeFailure {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("outcome"),
+			"Invalid outcome",
+			fmt.Sprintf("Expected %s or %s, got: %q", deliveryOutcomeSuccess, deliveryOutcomeFailure, outcome),
+		)
+	}
+
+	since := parseOptionalTime(data.Since, path.Root("since"), &resp.Diagnostics)
+	until := parseOptionalTime(data.Until, path.Root("until"), &resp.Diagnostics)
+
+	limit := defaultDeliveriesLimit
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	if limit < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid limit",
+			fmt.Sprintf("Expected limit of 0 or more, got: %d", limit),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Read webhookDeliveriesDataSource", map[string]interface{}{
+		"id":      id,
+		"outcome": outcome,
+	})
+
+	input := violet.ListWebhookDeliveriesInput{
+		Since: since,
+		Until: until,
+		Limit: limit,
+	}
+	// Violet does not filter by outcome, so the client does it while paging and stops at the limit.
+	if outcome != "" {
+		input.Match = func(delivery violet.VioletWebhookDelivery) bool {
+			return delivery.Succeeded() == (outcome == deliveryOutcomeSuccess)
+		}
+	}
+
+	err, deliveries := d.client.ListWebhookDeliveries(ctx, id, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading deliveries of Violet webhook id: %d", id),
+			"error getting webhook deliveries: "+err.Error(),
+		)
+		return
+	}
+
+	data.Deliveries = []webhookDeliveryModel{}
+
+	for _, delivery := range deliveries {
+		data.Deliveries = append(data.Deliveries, webhookDeliveryModel{
+			Id:              types.Int64Value(delivery.Id),
+			EventId:         types.StringValue(delivery.EventId),
+			Event:           types.StringValue(delivery.Event),
+			StatusCode:      types.Int64Value(delivery.StatusCode),
+			Attempts:        types.Int64Value(delivery.Attempts),
+			Success:         types.BoolValue(delivery.Succeeded()),
+			ErrorMessage:    types.StringValue(delivery.ErrorMessage),
+			DateCreated:     types.StringValue(delivery.DateCreated),
+			DateLastAttempt: types.StringValue(delivery.DateLastAttempt),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// parseOptionalTime parses an optional RFC 3339 attribute, returning zero time when it is not set.
+func parseOptionalTime(value types.String, attributePath path.Path, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid time", "Expected RFC 3339 time: "+err.Error())
+	}
+
+	return t
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+var (
+	_ datasource.DataSource              = &webhookHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookHealthDataSource{}
+)
+
+const defaultHealthWindow = "24h"
+
+func WebhookHealthDataSource() datasource.DataSource {
+	return &webhookHealthDataSource{}
+}
+
+type webhookHealthDataSource struct {
+	client *violet.VioletClient
+}
+
+func (d *webhookHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*violet.VioletClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected violet.VioletClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *webhookHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_webhook_health"
+}
+
+type webhookHealthModel struct {
+	WebhookId           types.Int64   `tfsdk:"webhook_id"`
+	Window              types.String  `tfsdk:"window"`
+	Status              types.String  `tfsdk:"status"`
+	Deliveries          types.Int64   `tfsdk:"deliveries"`
+	FailedDeliveries    types.Int64   `tfsdk:"failed_deliveries"`
+	FailureRate         types.Float64 `tfsdk:"failure_rate"`
+	ConsecutiveFailures types.Int64   `tfsdk:"consecutive_failures"`
+	LastSuccess         types.String  `tfsdk:"last_success"`
+	LastFailure         types.String  `tfsdk:"last_failure"`
+}
+
+func (d *webhookHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get health of Violet webhook computed from its recent deliveries, e.g. for use in check blocks",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Webhook id",
+			},
+			"window": schema.StringAttribute{
+				Optional:    true,
+				Description: "Duration, e.g. 1h or 30m, of the time window of deliveries the health is computed from. Defaults to " + defaultHealthWindow,
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of webhook",
+			},
+			"deliveries": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of deliveries in the window",
+			},
+			"failed_deliveries": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of deliveries in the window the remote endpoint did not accept",
+			},
+			"failure_rate": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Ratio of failed deliveries to all deliveries in the window, 0 when there were no deliveries",
+			},
+			"consecutive_failures": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of most recent deliveries that failed in a row",
+			},
+			"last_success": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of the last successful delivery in the window, empty when there was none",
+			},
+			"last_failure": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of the last failed delivery in the window, empty when there was none",
+			},
+		},
+	}
+}
+
+func (d *webhookHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookHealthModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.WebhookId.ValueInt64()
+
+	window := defaultHealthWindow
+	if !data.Window.IsNull() {
+		window = data.Window.ValueString()
+	}
+
+	windowDuration, err := time.ParseDuration(window)
+	if err != nil || windowDuration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window"),
+			"Invalid window",
+			fmt.Sprintf("Expected positive duration, e.g. 24h, got: %q", window),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Read webhookHealthDataSource", map[string]interface{}{
+		"id":     id,
+		"window": window,
+	})
+
+	err, webhook := d.client.GetWebhook(ctx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet webhook id: %d", id),
+			"error getting webhook: "+err.Error(),
+		)
+		return
+	}
+
+	err, deliveries := d.client.ListWebhookDeliveries(ctx, id, violet.ListWebhookDeliveriesInput{
+		Since: time.Now().Add(-windowDuration),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading deliveries of Violet webhook id: %d", id),
+			"error getting webhook deliveries: "+err.Error(),
+		)
+		return
+	}
+
+	health := violet.WebhookHealth(deliveries)
+
+	data.Status = types.StringValue(webhook.Status)
+	data.Deliveries = types.Int64Value(health.Deliveries)
+	data.FailedDeliveries = types.Int64Value(health.FailedDeliveries)
+	data.FailureRate = types.Float64Value(health.FailureRate)
+	data.ConsecutiveFailures = types.Int64Value(health.ConsecutiveFailures)
+	data.LastSuccess = types.StringValue(health.LastSuccess)
+	data.LastFailure = types.StringValue(health.LastFailure)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/rutkowskib/terraform-provider-violet/webhook"
+)
+
+var (
+	_ datasource.DataSource = &webhookPayloadSchemaDataSource{}
+)
+
+func WebhookPayloadSchemaDataSource() datasource.DataSource {
+	return &webhookPayloadSchemaDataSource{}
+}
+
+type webhookPayloadSchemaDataSource struct{}
+
+func (d *webhookPayloadSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_webhook_payload_schema"
+}
+
+type webhookPayloadSchemaModel struct {
+	Event   types.String `tfsdk:"event"`
+	Version types.String `tfsdk:"version"`
+	Schema  types.String `tfsdk:"schema"`
+}
+
+func (d *webhookPayloadSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get JSON Schema of the payload of Violet webhook event deliveries. Violet is not called.",
+		Attributes: map[string]schema.Attribute{
+			"event": schema.StringAttribute{
+				Required:    true,
+				Description: "Event to get payload schema of",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the schemas",
+			},
+			"schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON Schema (draft 2020-12) of the payload",
+			},
+		},
+	}
+}
+
+func (d *webhookPayloadSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookPayloadSchemaModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event := webhook.Event(data.Event.ValueString())
+
+	payloadSchema, err := webhook.PayloadSchema(event)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("event"),
+			fmt.Sprintf("Error reading payload schema of %s", event),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Version = types.StringValue(webhook.SchemaVersion)
+	data.Schema = types.StringValue(string(payloadSchema))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
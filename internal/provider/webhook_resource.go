@@ -4,9 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +27,7 @@ var (
 	_ resource.Resource                = &WebhookResource{}
 	_ resource.ResourceWithConfigure   = &WebhookResource{}
 	_ resource.ResourceWithImportState = &WebhookResource{}
+	_ resource.ResourceWithModifyPlan  = &WebhookResource{}
 )
 
 // NewWebhookResource is a helper function to simplify the provider implementation.
@@ -29,6 +37,8 @@ func NewWebhookResource() resource.Resource {
 
 type WebhookResource struct {
 	client *violet.VioletClient
+	// deletionProtection is the provider-wide default used when deletion_protection is not configured.
+	deletionProtection bool
 }
 
 // Metadata returns the resource type name.
@@ -42,41 +52,85 @@ func (r *WebhookResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*violet.VioletClient)
+	data, ok := req.ProviderData.(*violetProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.deletionProtection = data.DeletionProtection
 }
 
 type WebhookResourceModel struct {
-	Id               types.Int64  `tfsdk:"id"`
-	AppId            types.Int64  `tfsdk:"app_id"`
-	Event            types.String `tfsdk:"event"`
-	RemoteEndpoint   types.String `tfsdk:"remote_endpoint"`
-	Status           types.String `tfsdk:"status"`
-	DateCreated      types.String `tfsdk:"date_created"`
-	DateLastModified types.String `tfsdk:"date_last_modified"`
+	Id                 types.Int64    `tfsdk:"id"`
+	AppId              types.Int64    `tfsdk:"app_id"`
+	Event              types.String   `tfsdk:"event"`
+	RemoteEndpoint     types.String   `tfsdk:"remote_endpoint"`
+	Status             types.String   `tfsdk:"status"`
+	DateCreated        types.String   `tfsdk:"date_created"`
+	DateLastModified   types.String   `tfsdk:"date_last_modified"`
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	WaitForStatus      types.String   `tfsdk:"wait_for_status"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+const (
+	defaultWebhookCreateTimeout = 5 * time.Minute
+	defaultWebhookDeleteTimeout = 5 * time.Minute
+)
+
+// newWebhookResourceModel builds resource state from the webhook returned by Violet,
+// carrying over attributes that exist only in Terraform from the given model.
+func newWebhookResourceModel(webhook violet.VioletWebhook, from WebhookResourceModel) WebhookResourceModel {
+	adoptExisting := from.AdoptExisting
+	if adoptExisting.IsNull() || adoptExisting.IsUnknown() {
+		adoptExisting = types.BoolValue(false)
+	}
+
+	deletionProtection := from.DeletionProtection
+	if deletionProtection.IsUnknown() {
+		deletionProtection = types.BoolNull()
+	}
+
+	return WebhookResourceModel{
+		Id:                 types.Int64Value(webhook.Id),
+		AppId:              types.Int64Value(webhook.AppId),
+		Event:              types.StringValue(webhook.Event),
+		RemoteEndpoint:     types.StringValue(webhook.RemoteEndpoint),
+		Status:             types.StringValue(webhook.Status),
+		DateCreated:        types.StringValue(webhook.DateCreated),
+		DateLastModified:   types.StringValue(webhook.DateLastModified),
+		AdoptExisting:      adoptExisting,
+		DeletionProtection: deletionProtection,
+		WaitForStatus:      from.WaitForStatus,
+		Timeouts:           from.Timeouts,
+	}
 }
 
 // Schema defines the schema for the resource.
-func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WebhookResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource to manage Violet webhook",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 				Description: "Webhook id",
 			},
 			"app_id": schema.Int64Attribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 				Description: "App Id of application this webhook belongs to",
 			},
 			"event": schema.StringAttribute{
@@ -94,29 +148,145 @@ func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Endpoint that webhook will be publishing to",
 			},
 			"status": schema.StringAttribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Description: "Status of webhook",
 			},
 			"date_created": schema.StringAttribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Description: "Creation date of webhook",
 			},
+			// Every change of the webhook in Violet requires replacement, so the prior value is only
+			// kept for in-place updates, which never modify the webhook.
 			"date_last_modified": schema.StringAttribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Description: "Date of last modification of the webhook",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If enabled, an existing webhook with the same event and remote endpoint is adopted on create instead of creating a duplicate",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "If enabled, the webhook cannot be deleted or replaced until the flag is disabled in a separate apply. " +
+					"Defaults to deletion_protection of the provider",
+			},
+			"wait_for_status": schema.StringAttribute{
+				Optional: true,
+				Description: "Status to wait for after the webhook is created or adopted, e.g. ACTIVE. When set, deleting the webhook also waits until it is gone. " +
+					"Waiting is limited by create and delete timeouts",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ModifyPlan fills deletion_protection with the provider default and blocks destroying or replacing protected webhooks.
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state WebhookResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if req.Plan.Raw.IsNull() {
+		if state.DeletionProtection.ValueBool() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Violet webhook id: %d is protected from deletion", state.Id.ValueInt64()),
+				"Set deletion_protection to false and apply before destroying the webhook.",
+			)
+		}
+		return
+	}
+
+	var config, plan WebhookResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DeletionProtection.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), r.deletionProtection)...)
+	}
+
+	// RequiresReplace of attributes is only merged into the response after ModifyPlan returns,
+	// so attributes requiring replacement are compared here. Unknown values may change, so they count as a change.
+	replaced := !req.State.Raw.IsNull() && (!plan.Event.Equal(state.Event) || !plan.RemoteEndpoint.Equal(state.RemoteEndpoint))
+
+	if replaced && state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Violet webhook id: %d is protected from deletion", state.Id.ValueInt64()),
+			"The planned change requires replacing the webhook. Set deletion_protection to false and apply before making this change.",
+		)
+	}
+}
+
+// ImportState imports a webhook either by its numeric id or by "EVENT|remote_endpoint".
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	id, _ := strconv.Atoi(req.ID)
-	state := WebhookResourceModel{
-		Id: types.Int64Value(int64(id)),
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+
+	if err == nil && id <= 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier to be a positive webhook id. Got: %q", req.ID),
+		)
+		return
 	}
 
-	resp.State.Set(ctx, state)
+	if err != nil {
+		event, remoteEndpoint, found := strings.Cut(req.ID, "|")
+
+		if !found || event == "" || remoteEndpoint == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier to be a numeric webhook id or have format EVENT|remote_endpoint. Got: %q", req.ID),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Import webhook by event and remote endpoint", map[string]interface{}{
+			"event":           event,
+			"remote_endpoint": remoteEndpoint,
+		})
+
+		err, webhook, ok := r.client.FindWebhook(ctx, event, remoteEndpoint)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Violet webhook",
+				"Listing webhooks failed: "+err.Error(),
+			)
+			return
+		}
+
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Error importing Violet webhook",
+				fmt.Sprintf("No webhook found for event %q and remote endpoint %q", event, remoteEndpoint),
+			)
+			return
+		}
+
+		id = webhook.Id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -133,38 +303,92 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 		"remote_endpoint": plan.RemoteEndpoint.ValueString(),
 	})
 
-	input := violet.CreateWebhookInput{
-		Event:          plan.Event.ValueString(),
-		RemoteEndpoint: plan.RemoteEndpoint.ValueString(),
+	var webhook violet.VioletWebhook
+	adopted := false
+
+	if plan.AdoptExisting.ValueBool() {
+		err, existing, found := r.client.FindWebhook(ctx, plan.Event.ValueString(), plan.RemoteEndpoint.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Violet webhook",
+				"Looking up existing webhook failed: "+err.Error(),
+			)
+			return
+		}
+
+		if found {
+			tflog.Warn(ctx, "Adopting existing webhook", map[string]interface{}{
+				"id":              existing.Id,
+				"event":           existing.Event,
+				"remote_endpoint": existing.RemoteEndpoint,
+			})
+			resp.Diagnostics.AddWarning(
+				"Adopted existing Violet webhook",
+				fmt.Sprintf("Webhook id: %d with event %s and remote endpoint %s already existed and was adopted instead of creating a new one.", existing.Id, existing.Event, existing.RemoteEndpoint),
+			)
+
+			// Adopted webhook goes through the same wait_for_status as a created one.
+			webhook = existing
+			adopted = true
+		}
 	}
-	err, webhook := r.client.CreateWebhook(ctx, input)
 
-	if err != nil {
-		tflog.Error(ctx, "Error creating webhook data", map[string]interface{}{
-			"err": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"Error creating Violet webhook",
-			"Creating webhook failed: "+err.Error(),
-		)
+	if !adopted {
+		input := violet.CreateWebhookInput{
+			Event:          plan.Event.ValueString(),
+			RemoteEndpoint: plan.RemoteEndpoint.ValueString(),
+		}
+
+		var err error
+		err, webhook = r.client.CreateWebhook(ctx, input)
+
+		if err != nil {
+			tflog.Error(ctx, "Error creating webhook data", map[string]interface{}{
+				"err": err.Error(),
+			})
+			resp.Diagnostics.AddError(
+				"Error creating Violet webhook",
+				"Creating webhook failed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state := newWebhookResourceModel(webhook, plan)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state := WebhookResourceModel{
-		Id:               types.Int64Value(webhook.Id),
-		AppId:            types.Int64Value(webhook.AppId),
-		Event:            types.StringValue(webhook.Event),
-		RemoteEndpoint:   types.StringValue(webhook.RemoteEndpoint),
-		Status:           types.StringValue(webhook.Status),
-		DateCreated:      types.StringValue(webhook.DateCreated),
-		DateLastModified: types.StringValue(webhook.DateLastModified),
+	if plan.WaitForStatus.IsNull() {
+		return
 	}
 
-	diags = resp.State.Set(ctx, state)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultWebhookCreateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id := webhook.Id
+	err, webhook := r.client.WaitForWebhookStatus(waitCtx, id, plan.WaitForStatus.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error waiting for Violet webhook id: %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newWebhookResourceModel(webhook, plan))
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -192,15 +416,7 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	state := WebhookResourceModel{
-		Id:               types.Int64Value(webhook.Id),
-		AppId:            types.Int64Value(webhook.AppId),
-		Event:            types.StringValue(webhook.Event),
-		RemoteEndpoint:   types.StringValue(webhook.RemoteEndpoint),
-		Status:           types.StringValue(webhook.Status),
-		DateCreated:      types.StringValue(webhook.DateCreated),
-		DateLastModified: types.StringValue(webhook.DateLastModified),
-	}
+	state := newWebhookResourceModel(webhook, oldState)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -210,7 +426,18 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
+// Event and remote endpoint require replacement, so only attributes managed by Terraform itself
+// can change in place and nothing is sent to Violet.
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WebhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -224,6 +451,14 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	id := state.Id.ValueInt64()
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Violet webhook id: %d is protected from deletion", id),
+			"Set deletion_protection to false and apply before destroying the webhook.",
+		)
+		return
+	}
+
 	tflog.Info(ctx, "Delete webhook resource", map[string]interface{}{
 		"id": id,
 	})
@@ -235,5 +470,28 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 			fmt.Sprintf("Error deleting Violet webhook id: %d", id),
 			"Delete webhook failed: "+err.Error(),
 		)
+		return
+	}
+
+	if state.WaitForStatus.IsNull() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultWebhookDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err = r.client.WaitForWebhookDeleted(waitCtx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error waiting for Violet webhook id: %d", id),
+			err.Error(),
+		)
 	}
 }
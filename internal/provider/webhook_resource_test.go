@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWebhookResourceModifyPlanDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	r := &WebhookResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	timeoutsType, diags := s.TypeAtPath(ctx, path.Root("timeouts"))
+	if diags.HasError() {
+		t.Fatalf("timeouts type: %v", diags)
+	}
+
+	model := func(event string, remoteEndpoint string, deletionProtection bool) WebhookResourceModel {
+		return WebhookResourceModel{
+			Id:                 types.Int64Value(2214),
+			AppId:              types.Int64Value(10099),
+			Event:              types.StringValue(event),
+			RemoteEndpoint:     types.StringValue(remoteEndpoint),
+			Status:             types.StringValue("ACTIVE"),
+			DateCreated:        types.StringValue("2024-01-01T00:00:00+0000"),
+			DateLastModified:   types.StringValue("2024-01-01T00:00:00+0000"),
+			AdoptExisting:      types.BoolValue(false),
+			DeletionProtection: types.BoolValue(deletionProtection),
+			WaitForStatus:      types.StringNull(),
+			Timeouts:           timeouts.Value{Object: types.ObjectNull(timeoutsType.(timeouts.Type).AttrTypes)},
+		}
+	}
+
+	raw := func(m WebhookResourceModel) tftypes.Value {
+		state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, m); diags.HasError() {
+			t.Fatalf("set model: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := map[string]struct {
+		state     WebhookResourceModel
+		plan      WebhookResourceModel
+		wantError bool
+	}{
+		"protected event change": {
+			state:     model("ORDER_UPDATED", "https://example.com/hooks", true),
+			plan:      model("ORDER_SHIPPED", "https://example.com/hooks", true),
+			wantError: true,
+		},
+		"protected remote endpoint change": {
+			state:     model("ORDER_UPDATED", "https://example.com/hooks", true),
+			plan:      model("ORDER_UPDATED", "https://example.com/other", true),
+			wantError: true,
+		},
+		"protected remote endpoint change while disabling protection": {
+			state:     model("ORDER_UPDATED", "https://example.com/hooks", true),
+			plan:      model("ORDER_UPDATED", "https://example.com/other", false),
+			wantError: true,
+		},
+		"protected in-place change": {
+			state: model("ORDER_UPDATED", "https://example.com/hooks", true),
+			plan:  model("ORDER_UPDATED", "https://example.com/hooks", false),
+		},
+		"unprotected replacement": {
+			state: model("ORDER_UPDATED", "https://example.com/hooks", false),
+			plan:  model("ORDER_SHIPPED", "https://example.com/hooks", false),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			planRaw := raw(test.plan)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: planRaw},
+				Plan:   tfsdk.Plan{Schema: s, Raw: planRaw},
+				State:  tfsdk.State{Schema: s, Raw: raw(test.state)},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			r.ModifyPlan(ctx, req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWebhookResourceImportStateInvalidId(t *testing.T) {
+	ctx := context.Background()
+	r := &WebhookResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	for _, id := range []string{"0", "-1", "ORDER_UPDATED", "|https://example.com/hooks"} {
+		t.Run(id, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+
+			r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+
+			if !resp.Diagnostics.HasError() {
+				t.Errorf("expected error for import id %q", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/rutkowskib/terraform-provider-violet/webhook"
+)
+
+var (
+	_ datasource.DataSource = &webhookSamplePayloadDataSource{}
+)
+
+func WebhookSamplePayloadDataSource() datasource.DataSource {
+	return &webhookSamplePayloadDataSource{}
+}
+
+type webhookSamplePayloadDataSource struct{}
+
+// sampleTimestampNow is the timestamp value opting in to current time, which changes headers on every read.
+const sampleTimestampNow = "now"
+
+func (d *webhookSamplePayloadDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "violet_webhook_sample_payload"
+}
+
+type webhookSamplePayloadModel struct {
+	Event     types.String `tfsdk:"event"`
+	Secret    types.String `tfsdk:"secret"`
+	WebhookId types.Int64  `tfsdk:"webhook_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+	Version   types.String `tfsdk:"version"`
+	Body      types.String `tfsdk:"body"`
+	Headers   types.Map    `tfsdk:"headers"`
+	Signature types.String `tfsdk:"signature"`
+}
+
+func (d *webhookSamplePayloadDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to get an example delivery of Violet webhook event, with headers and signature Violet would send. Violet is not called.",
+		Attributes: map[string]schema.Attribute{
+			"event": schema.StringAttribute{
+				Required:    true,
+				Description: "Event to get example delivery of",
+			},
+			"secret": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "App secret to sign the delivery with",
+			},
+			"webhook_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Webhook id sent in headers. Defaults to 0",
+			},
+			"timestamp": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC 3339 time of the delivery sent in headers, or \"now\" for current time. Defaults to date_created of the example payload, so headers and signature stay the same between runs",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the example payloads",
+			},
+			"body": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON body of the delivery",
+			},
+			"headers": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Headers of the delivery",
+			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Signature of the body, the same as in " + webhook.HeaderHmac + " header",
+			},
+		},
+	}
+}
+
+func (d *webhookSamplePayloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookSamplePayloadModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event := webhook.Event(data.Event.ValueString())
+
+	if !event.Known() {
+		known := make([]string, 0, len(webhook.Events()))
+		for _, e := range webhook.Events() {
+			known = append(known, string(e))
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("event"),
+			"Unknown Violet webhook event",
+			fmt.Sprintf("Event %q is not known. Known events: %s", event, strings.Join(known, ", ")),
+		)
+		return
+	}
+
+	var timestamp time.Time
+	if data.Timestamp.ValueString() == sampleTimestampNow {
+		timestamp = time.Now()
+	} else {
+		timestamp = parseOptionalTime(data.Timestamp, path.Root("timestamp"), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if timestamp.IsZero() {
+		var err error
+		timestamp, err = webhook.SampleTimestamp(event)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading example payload of %s", event),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	body, err := webhook.SamplePayload(event)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading example payload of %s", event),
+			err.Error(),
+		)
+		return
+	}
+
+	secret := data.Secret.ValueString()
+	headers, diags := types.MapValueFrom(ctx, types.StringType, webhook.Headers(event, data.WebhookId.ValueInt64(), body, secret, timestamp))
+	resp.Diagnostics.Append(diags...)
+
+	data.Version = types.StringValue(webhook.SampleVersion)
+	data.Body = types.StringValue(string(body))
+	data.Headers = headers
+	data.Signature = types.StringValue(webhook.Sign(body, secret))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/rutkowskib/terraform-provider-violet/webhook"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &webhookSignatureFunction{}
+)
+
+// NewWebhookSignatureFunction is a helper function to simplify the provider implementation.
+func NewWebhookSignatureFunction() function.Function {
+	return &webhookSignatureFunction{}
+}
+
+type webhookSignatureFunction struct{}
+
+// Metadata returns the function name.
+func (f *webhookSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_signature"
+}
+
+// Definition defines the function parameters and return type.
+func (f *webhookSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute Violet webhook signature",
+		Description: "Computes the signature Violet sends in the " + webhook.HeaderHmac + " header of a webhook delivery: base64 encoded HMAC-SHA256 of the body keyed with the app secret.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "body",
+				Description: "Body of the webhook delivery",
+			},
+			function.StringParameter{
+				Name:        "secret",
+				Description: "Violet App Secret",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the signature.
+func (f *webhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var body, secret string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &body, &secret))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, webhook.Sign([]byte(body), secret)))
+}
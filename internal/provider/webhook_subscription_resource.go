@@ -0,0 +1,405 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &WebhookSubscriptionResource{}
+	_ resource.ResourceWithConfigure   = &WebhookSubscriptionResource{}
+	_ resource.ResourceWithImportState = &WebhookSubscriptionResource{}
+)
+
+// NewWebhookSubscriptionResource is a helper function to simplify the provider implementation.
+func NewWebhookSubscriptionResource() resource.Resource {
+	return &WebhookSubscriptionResource{}
+}
+
+// WebhookSubscriptionResource manages one Violet webhook per event, all publishing to the same endpoint.
+type WebhookSubscriptionResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *WebhookSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_subscription"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WebhookSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type WebhookSubscriptionResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	RemoteEndpoint types.String `tfsdk:"remote_endpoint"`
+	Events         types.Set    `tfsdk:"events"`
+	WebhookIds     types.Map    `tfsdk:"webhook_ids"`
+}
+
+// Schema defines the schema for the resource.
+func (r *WebhookSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to subscribe one endpoint to many Violet webhook events. " +
+			"A separate Violet webhook is managed for every event. Webhooks that are no longer active are re-activated on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Subscription id, equal to the remote endpoint",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_endpoint": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Endpoint that webhooks will be publishing to",
+			},
+			"events": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Events the endpoint will be subscribed to",
+			},
+			"webhook_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Map of event to id of the webhook subscribed to it",
+			},
+		},
+	}
+}
+
+func (r *WebhookSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier to be the remote endpoint of the webhooks.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_endpoint"), req.ID)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WebhookSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebhookSubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var events []string
+	resp.Diagnostics.Append(plan.Events.ElementsAs(ctx, &events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookIds := r.reconcile(ctx, plan.RemoteEndpoint.ValueString(), events, map[string]int64{}, &resp.Diagnostics)
+
+	// Save webhooks created so far even on error, so they are not orphaned.
+	state, diags := newWebhookSubscriptionResourceModel(ctx, plan.RemoteEndpoint.ValueString(), webhookIds, nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WebhookSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState WebhookSubscriptionResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteEndpoint := oldState.RemoteEndpoint.ValueString()
+
+	tflog.Info(ctx, "Read webhook subscription resource", map[string]interface{}{
+		"remote_endpoint": remoteEndpoint,
+	})
+
+	var webhooks []violet.VioletWebhook
+
+	if oldState.WebhookIds.IsNull() {
+		// Imported subscription, discover webhooks publishing to the endpoint.
+		err, all := r.client.ListWebhooks(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Violet webhook subscription",
+				"Listing webhooks failed: "+err.Error(),
+			)
+			return
+		}
+
+		for _, webhook := range all {
+			if webhook.RemoteEndpoint == remoteEndpoint {
+				webhooks = append(webhooks, webhook)
+			}
+		}
+	} else {
+		var webhookIds map[string]int64
+		resp.Diagnostics.Append(oldState.WebhookIds.ElementsAs(ctx, &webhookIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, id := range webhookIds {
+			err, webhook := r.client.GetWebhook(ctx, id)
+
+			if violet.IsNotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Error reading Violet webhook id: %d", id),
+					"Get webhook failed: "+err.Error(),
+				)
+				return
+			}
+
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	// Only the webhook with the lowest id is kept for each event, the same as violet_app_webhooks does.
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].Id < webhooks[j].Id
+	})
+
+	webhookIds := map[string]int64{}
+	activeEvents := []string{}
+	var duplicates []string
+
+	for _, webhook := range webhooks {
+		if _, ok := webhookIds[webhook.Event]; ok {
+			duplicates = append(duplicates, fmt.Sprintf("%s (id: %d)", webhook.Event, webhook.Id))
+			continue
+		}
+
+		webhookIds[webhook.Event] = webhook.Id
+
+		if webhook.Status == violet.WebhookStatusActive {
+			activeEvents = append(activeEvents, webhook.Event)
+		}
+	}
+
+	if len(duplicates) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Duplicate Violet webhooks are not managed",
+			fmt.Sprintf("More than one webhook publishes the same event to %s. Only the one with the lowest id is managed, "+
+				"the following webhooks are not and can be deleted or imported as violet_webhook:\n%s", remoteEndpoint, strings.Join(duplicates, "\n")),
+		)
+	}
+
+	state, diags := newWebhookSubscriptionResourceModel(ctx, remoteEndpoint, webhookIds, activeEvents)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WebhookSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, oldState WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &oldState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var events []string
+	resp.Diagnostics.Append(plan.Events.ElementsAs(ctx, &events, false)...)
+
+	webhookIds := map[string]int64{}
+	resp.Diagnostics.Append(oldState.WebhookIds.ElementsAs(ctx, &webhookIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookIds = r.reconcile(ctx, plan.RemoteEndpoint.ValueString(), events, webhookIds, &resp.Diagnostics)
+
+	state, diags := newWebhookSubscriptionResourceModel(ctx, plan.RemoteEndpoint.ValueString(), webhookIds, nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WebhookSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WebhookSubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookIds := map[string]int64{}
+	resp.Diagnostics.Append(state.WebhookIds.ElementsAs(ctx, &webhookIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Delete webhook subscription resource", map[string]interface{}{
+		"remote_endpoint": state.RemoteEndpoint.ValueString(),
+	})
+
+	webhookIds = r.reconcile(ctx, state.RemoteEndpoint.ValueString(), nil, webhookIds, &resp.Diagnostics)
+
+	// Keep webhooks that failed to delete in state, so they can be retried.
+	if resp.Diagnostics.HasError() {
+		newState, diags := newWebhookSubscriptionResourceModel(ctx, state.RemoteEndpoint.ValueString(), webhookIds, nil)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	}
+}
+
+// reconcile makes Violet webhooks for the endpoint match the given events. Webhooks of events that are not
+// desired are deleted, missing ones are created and inactive ones are re-activated. It returns the webhooks
+// that exist after reconciliation, stopping on the first error.
+func (r *WebhookSubscriptionResource) reconcile(ctx context.Context, remoteEndpoint string, events []string, webhookIds map[string]int64, diags *diag.Diagnostics) map[string]int64 {
+	result := make(map[string]int64, len(webhookIds))
+	for event, id := range webhookIds {
+		result[event] = id
+	}
+
+	desired := make(map[string]bool, len(events))
+	for _, event := range events {
+		desired[event] = true
+	}
+
+	for _, event := range sortedKeys(result) {
+		if desired[event] {
+			continue
+		}
+
+		id := result[event]
+		err := r.client.DeleteWebhook(ctx, id)
+
+		if err != nil && !violet.IsNotFound(err) {
+			diags.AddError(
+				fmt.Sprintf("Error deleting Violet webhook id: %d", id),
+				"Delete webhook failed: "+err.Error(),
+			)
+			return result
+		}
+
+		delete(result, event)
+	}
+
+	sort.Strings(events)
+
+	for _, event := range events {
+		if id, ok := result[event]; ok {
+			err, webhook := r.client.GetWebhook(ctx, id)
+
+			if err == nil && webhook.Status == violet.WebhookStatusActive {
+				continue
+			}
+
+			if err == nil {
+				tflog.Info(ctx, "Re-activating webhook", map[string]interface{}{
+					"id":     id,
+					"status": webhook.Status,
+				})
+				err, _ = r.client.ActivateWebhook(ctx, id)
+			}
+
+			if err == nil {
+				continue
+			}
+
+			if !violet.IsNotFound(err) {
+				diags.AddError(
+					fmt.Sprintf("Error activating Violet webhook id: %d", id),
+					"Activate webhook failed: "+err.Error(),
+				)
+				return result
+			}
+
+			// Webhook was removed outside of Terraform, create it again.
+			delete(result, event)
+		}
+
+		err, webhook := r.client.CreateWebhook(ctx, violet.CreateWebhookInput{
+			Event:          event,
+			RemoteEndpoint: remoteEndpoint,
+		})
+
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error creating Violet webhook for event %s", event),
+				"Creating webhook failed: "+err.Error(),
+			)
+			return result
+		}
+
+		result[event] = webhook.Id
+	}
+
+	return result
+}
+
+// newWebhookSubscriptionResourceModel builds resource state. When activeEvents is nil, events are all keys of webhookIds.
+func newWebhookSubscriptionResourceModel(ctx context.Context, remoteEndpoint string, webhookIds map[string]int64, activeEvents []string) (WebhookSubscriptionResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if activeEvents == nil {
+		activeEvents = sortedKeys(webhookIds)
+	}
+
+	events, d := types.SetValueFrom(ctx, types.StringType, activeEvents)
+	diags.Append(d...)
+
+	ids, d := types.MapValueFrom(ctx, types.Int64Type, webhookIds)
+	diags.Append(d...)
+
+	return WebhookSubscriptionResourceModel{
+		Id:             types.StringValue(remoteEndpoint),
+		RemoteEndpoint: types.StringValue(remoteEndpoint),
+		Events:         events,
+		WebhookIds:     ids,
+	}, diags
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
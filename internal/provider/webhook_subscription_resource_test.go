@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+)
+
+func TestWebhookSubscriptionResourceReadImportedDuplicates(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"content": [
+			{"id": 7, "event": "ORDER_UPDATED", "remote_endpoint": "https://example.com/hooks", "status": "ACTIVE"},
+			{"id": 3, "event": "ORDER_UPDATED", "remote_endpoint": "https://example.com/hooks", "status": "ACTIVE"},
+			{"id": 5, "event": "ORDER_SHIPPED", "remote_endpoint": "https://example.com/hooks", "status": "ACTIVE"},
+			{"id": 9, "event": "ORDER_UPDATED", "remote_endpoint": "https://other.example.com/hooks", "status": "ACTIVE"}
+		], "last": true, "total_pages": 1}`)
+	}))
+	defer server.Close()
+
+	r := &WebhookSubscriptionResource{client: &violet.VioletClient{AppId: "10099", BaseUrl: server.URL + "/"}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, WebhookSubscriptionResourceModel{
+		Id:             types.StringValue("https://example.com/hooks"),
+		RemoteEndpoint: types.StringValue("https://example.com/hooks"),
+		Events:         types.SetNull(types.StringType),
+		WebhookIds:     types.MapNull(types.Int64Type),
+	})
+	if diags.HasError() {
+		t.Fatalf("set state: %v", diags)
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+
+	webhookIds := map[string]int64{}
+	resp.Diagnostics.Append(got.WebhookIds.ElementsAs(ctx, &webhookIds, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read state: %v", resp.Diagnostics)
+	}
+
+	if len(webhookIds) != 2 || webhookIds["ORDER_UPDATED"] != 3 || webhookIds["ORDER_SHIPPED"] != 5 {
+		t.Errorf("expected lowest ids per event, got %v", webhookIds)
+	}
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "ORDER_UPDATED (id: 7)") {
+		t.Errorf("expected warning listing duplicate id 7, got %v", warnings)
+	}
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/rutkowskib/terraform-provider-violet/internal/violet"
+	"github.com/rutkowskib/terraform-provider-violet/webhook"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &WebhookTestResource{}
+	_ resource.ResourceWithConfigure = &WebhookTestResource{}
+)
+
+const (
+	webhookTestDeliveredByViolet   = "violet"
+	webhookTestDeliveredByProvider = "provider"
+
+	webhookTestTimeout = 30 * time.Second
+)
+
+// NewWebhookTestResource is a helper function to simplify the provider implementation.
+func NewWebhookTestResource() resource.Resource {
+	return &WebhookTestResource{}
+}
+
+// WebhookTestResource sends a sample event to the webhook endpoint whenever it is created or its triggers change.
+type WebhookTestResource struct {
+	client *violet.VioletClient
+}
+
+// Metadata returns the resource type name.
+func (r *WebhookTestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_test"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WebhookTestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*violetProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *violetProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+}
+
+type WebhookTestResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WebhookId   types.Int64  `tfsdk:"webhook_id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	StatusCode  types.Int64  `tfsdk:"status_code"`
+	LatencyMs   types.Int64  `tfsdk:"latency_ms"`
+	DeliveredBy types.String `tfsdk:"delivered_by"`
+	DeliveredAt types.String `tfsdk:"delivered_at"`
+}
+
+// Schema defines the schema for the resource.
+func (r *WebhookTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to send a sample event to the remote endpoint of a Violet webhook. " +
+			"Violet is asked to send the event and, where it cannot, the provider sends a correctly signed sample delivery itself. " +
+			"The event is sent again whenever triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Test delivery id",
+			},
+			"webhook_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Id of the webhook to test",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that cause the test delivery to be sent again when changed, e.g. remote endpoint of the webhook",
+			},
+			"status_code": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Status code the remote endpoint responded with",
+			},
+			"latency_ms": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Time in milliseconds it took to deliver the sample event",
+			},
+			"delivered_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "Who sent the sample event, violet or provider",
+			},
+			"delivered_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time the sample event was sent",
+			},
+		},
+	}
+}
+
+// Create sends the sample event and records the response of the remote endpoint.
+func (r *WebhookTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebhookTestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.WebhookId.ValueInt64()
+
+	tflog.Info(ctx, "Test webhook", map[string]interface{}{
+		"id": id,
+	})
+
+	err, hook := r.client.GetWebhook(ctx, id)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading Violet webhook id: %d", id),
+			"Get webhook failed: "+err.Error(),
+		)
+		return
+	}
+
+	deliveredAt := time.Now().UTC()
+	deliveredBy := webhookTestDeliveredByViolet
+
+	err, result := r.client.TriggerWebhookTest(ctx, id)
+	statusCode := result.StatusCode
+
+	if violet.IsNotSupported(err) {
+		tflog.Info(ctx, "Violet cannot send test event, sending sample delivery", map[string]interface{}{
+			"id":              id,
+			"remote_endpoint": hook.RemoteEndpoint,
+		})
+
+		deliveredBy = webhookTestDeliveredByProvider
+		deliveredAt = time.Now().UTC()
+		statusCode, err = r.sendSample(ctx, hook)
+	}
+
+	latency := time.Since(deliveredAt)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error testing Violet webhook id: %d", id),
+			"Sending test event failed: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%d-%d", id, deliveredAt.UnixNano()))
+	plan.StatusCode = types.Int64Value(statusCode)
+	plan.LatencyMs = types.Int64Value(latency.Milliseconds())
+	plan.DeliveredBy = types.StringValue(deliveredBy)
+	plan.DeliveredAt = types.StringValue(deliveredAt.Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// sendSample posts a signed sample delivery of the webhook event to its remote endpoint.
+func (r *WebhookTestResource) sendSample(ctx context.Context, hook violet.VioletWebhook) (int64, error) {
+	body, err := webhook.SamplePayload(webhook.Event(hook.Event))
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTestTimeout)
+	defer cancel()
+
+	request, err := webhook.NewRequest(ctx, hook.RemoteEndpoint, webhook.Event(hook.Event), hook.Id, body, r.client.CurrentAppSecret())
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	tflog.Info(ctx, "Sample delivery response", map[string]interface{}{
+		"status": response.StatusCode,
+	})
+
+	return int64(response.StatusCode), nil
+}
+
+// Read keeps the recorded test result, there is nothing to refresh.
+func (r *WebhookTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update is never called, as every change requires replacement.
+func (r *WebhookTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete removes the test result from the Terraform state.
+func (r *WebhookTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
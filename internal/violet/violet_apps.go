@@ -0,0 +1,181 @@
+package violet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VioletApp struct {
+	Id               int64
+	Name             string
+	Owner            string
+	Status           string
+	Currencies       []string
+	DateCreated      string
+	DateLastModified string
+}
+
+type violetAppResponse struct {
+	Id               int64    `json:"id"`
+	Name             string   `json:"name"`
+	Owner            string   `json:"owner"`
+	Status           string   `json:"status"`
+	Currencies       []string `json:"currencies"`
+	DateCreated      string   `json:"date_created"`
+	DateLastModified string   `json:"date_last_modified"`
+}
+
+// GetApp returns the app with given id. Empty id means the app the client is configured with.
+func (c *VioletClient) GetApp(ctx context.Context, appId string) (error, VioletApp) {
+	if appId == "" {
+		appId = c.AppId
+	}
+
+	path := fmt.Sprintf("apps/%s", appId)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting app", map[string]any{
+			"app_id": appId,
+			"err":    err.Error(),
+		})
+		return err, VioletApp{}
+	}
+
+	var data violetAppResponse
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetApp data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletApp{}
+	}
+
+	return nil, VioletApp(data)
+}
+
+type VioletAppSettings struct {
+	DisplayName      string   `json:"display_name"`
+	SupportEmail     string   `json:"support_email"`
+	DefaultCurrency  string   `json:"default_currency"`
+	AllowedCountries []string `json:"allowed_countries"`
+	CheckoutMode     string   `json:"checkout_mode"`
+	GuestCheckout    bool     `json:"guest_checkout"`
+}
+
+// GetAppSettings returns settings of the configured app.
+func (c *VioletClient) GetAppSettings(ctx context.Context) (error, VioletAppSettings) {
+	path := fmt.Sprintf("apps/%s/settings", c.AppId)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting app settings", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletAppSettings{}
+	}
+
+	var data VioletAppSettings
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetAppSettings data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletAppSettings{}
+	}
+
+	return nil, data
+}
+
+// UpdateAppSettings replaces settings of the configured app and returns the settings saved by Violet.
+func (c *VioletClient) UpdateAppSettings(ctx context.Context, settings VioletAppSettings) (error, VioletAppSettings) {
+	path := fmt.Sprintf("apps/%s/settings", c.AppId)
+
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return err, VioletAppSettings{}
+	}
+
+	tflog.Info(ctx, "Making update app settings request", map[string]any{
+		"settings": string(body),
+	})
+
+	err, res := c.makeRequest(ctx, "PUT", path, body)
+
+	if err != nil {
+		tflog.Error(ctx, "Error updating app settings", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletAppSettings{}
+	}
+
+	var data VioletAppSettings
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing UpdateAppSettings data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletAppSettings{}
+	}
+
+	return nil, data
+}
+
+// CurrentAppSecret returns the app secret the client authenticates with.
+func (c *VioletClient) CurrentAppSecret() string {
+	c.secretMu.RLock()
+	defer c.secretMu.RUnlock()
+
+	return c.AppSecret
+}
+
+// RotateAppSecret replaces the secret of the configured app with a new one generated by Violet.
+// The client keeps working, as it switches to the new secret.
+func (c *VioletClient) RotateAppSecret(ctx context.Context) (error, string) {
+	tflog.Info(ctx, "Rotating app secret")
+
+	path := fmt.Sprintf("apps/%s/secret/rotate", c.AppId)
+	// Response body contains the new secret, it must not end up in logs.
+	err, res := c.makeRequest(tflog.MaskFieldValuesWithFieldKeys(ctx, "body"), "POST", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error rotating app secret", map[string]any{
+			"err": err.Error(),
+		})
+		return err, ""
+	}
+
+	type rotateAppSecretResponse struct {
+		Secret string `json:"secret"`
+	}
+
+	var data rotateAppSecretResponse
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		// Response contains the secret, so it is not logged.
+		tflog.Error(ctx, "Error parsing RotateAppSecret data")
+		return err, ""
+	}
+
+	if data.Secret == "" {
+		return errors.New("Violet did not return the rotated app secret"), ""
+	}
+
+	c.secretMu.Lock()
+	c.AppSecret = data.Secret
+	c.secretMu.Unlock()
+
+	return nil, data.Secret
+}
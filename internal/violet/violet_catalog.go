@@ -0,0 +1,258 @@
+package violet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// VioletOffer is a product as offered by a single merchant. Prices are in minor units of the currency, e.g. cents.
+// Search results do not include SKUs.
+type VioletOffer struct {
+	Id          int64       `json:"id"`
+	ProductId   string      `json:"product_id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	MerchantId  int64       `json:"merchant_id"`
+	MinPrice    int64       `json:"min_price"`
+	MaxPrice    int64       `json:"max_price"`
+	Currency    string      `json:"currency"`
+	Status      string      `json:"status"`
+	Available   bool        `json:"available"`
+	Skus        []VioletSku `json:"skus"`
+}
+
+// VioletSku is a purchasable variant of an offer, e.g. a size and color of a shoe.
+type VioletSku struct {
+	Id                int64                `json:"id"`
+	OfferId           int64                `json:"offer_id"`
+	MerchantId        int64                `json:"merchant_id"`
+	Name              string               `json:"name"`
+	RetailPrice       int64                `json:"retail_price"`
+	SalePrice         int64                `json:"sale_price"`
+	Currency          string               `json:"currency"`
+	InStock           bool                 `json:"in_stock"`
+	QuantityAvailable int64                `json:"qty_available"`
+	VariantValues     []VioletVariantValue `json:"variant_values"`
+}
+
+// VioletVariantValue is a value of a variant attribute of a SKU, e.g. Size: 10.
+type VioletVariantValue struct {
+	Variant string `json:"variant"`
+	Value   string `json:"value"`
+}
+
+// VioletProduct groups offers of the same product sold by different merchants.
+type VioletProduct struct {
+	Id          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Brand       string        `json:"brand"`
+	Offers      []VioletOffer `json:"offers"`
+}
+
+// SearchOffersInput filters offers of the catalogue. Zero values do not filter.
+type SearchOffersInput struct {
+	MerchantId int64  `json:"merchant_id,omitempty"`
+	Query      string `json:"query,omitempty"`
+	Category   string `json:"category,omitempty"`
+	Available  *bool  `json:"available,omitempty"`
+	MinPrice   int64  `json:"min_price,omitempty"`
+	MaxPrice   int64  `json:"max_price,omitempty"`
+	// Limit is the maximum number of offers returned, 0 returns all matching offers.
+	Limit int `json:"-"`
+}
+
+// SearchOffers returns offers of the catalogue matching the input, going through pages until the limit is reached.
+func (c *VioletClient) SearchOffers(ctx context.Context, input SearchOffersInput) (error, []VioletOffer) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err, nil
+	}
+
+	size := listPageSize
+	if input.Limit > 0 && input.Limit < size {
+		size = input.Limit
+	}
+
+	var offers []VioletOffer
+
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("catalog/offers/search?page=%d&size=%d", page, size)
+		err, res := c.makeRequest(ctx, "POST", path, body)
+
+		if err != nil {
+			tflog.Error(ctx, "Error searching offers", map[string]any{
+				"page": page,
+				"err":  err.Error(),
+			})
+			return err, nil
+		}
+
+		var data violetPage[VioletOffer]
+
+		err = json.Unmarshal(res, &data)
+
+		if err != nil {
+			tflog.Error(ctx, "Error parsing SearchOffers data", map[string]any{
+				"res": string(res),
+			})
+			return err, nil
+		}
+
+		offers = append(offers, data.Content...)
+
+		if input.Limit > 0 && len(offers) >= input.Limit {
+			offers = offers[:input.Limit]
+			break
+		}
+
+		if data.Last || len(data.Content) == 0 || page >= data.TotalPages {
+			break
+		}
+	}
+
+	return nil, offers
+}
+
+func (c *VioletClient) GetOffer(ctx context.Context, id int64) (error, VioletOffer) {
+	path := fmt.Sprintf("catalog/offers/%d", id)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting offer", map[string]any{
+			"id":  id,
+			"err": err.Error(),
+		})
+		return err, VioletOffer{}
+	}
+
+	var data VioletOffer
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetOffer data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletOffer{}
+	}
+
+	data.fillSkuOwner()
+
+	return nil, data
+}
+
+func (c *VioletClient) GetProduct(ctx context.Context, id string) (error, VioletProduct) {
+	path := fmt.Sprintf("catalog/products/%s", url.PathEscape(id))
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting product", map[string]any{
+			"id":  id,
+			"err": err.Error(),
+		})
+		return err, VioletProduct{}
+	}
+
+	var data VioletProduct
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetProduct data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletProduct{}
+	}
+
+	for i := range data.Offers {
+		data.Offers[i].fillSkuOwner()
+	}
+
+	return nil, data
+}
+
+// fillSkuOwner sets offer and merchant of SKUs, which Violet may leave out of SKUs nested in the offer.
+func (o *VioletOffer) fillSkuOwner() {
+	for i := range o.Skus {
+		if o.Skus[i].OfferId == 0 {
+			o.Skus[i].OfferId = o.Id
+		}
+		if o.Skus[i].MerchantId == 0 {
+			o.Skus[i].MerchantId = o.MerchantId
+		}
+	}
+}
+
+// VioletCatalogExclusions lists parts of the catalogue hidden from the app.
+type VioletCatalogExclusions struct {
+	MerchantIds []int64  `json:"merchant_ids"`
+	OfferIds    []int64  `json:"offer_ids"`
+	Categories  []string `json:"categories"`
+}
+
+// GetCatalogExclusions returns parts of the catalogue hidden from the configured app.
+func (c *VioletClient) GetCatalogExclusions(ctx context.Context) (error, VioletCatalogExclusions) {
+	path := fmt.Sprintf("apps/%s/catalog/exclusions", c.AppId)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting catalog exclusions", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletCatalogExclusions{}
+	}
+
+	var data VioletCatalogExclusions
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetCatalogExclusions data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletCatalogExclusions{}
+	}
+
+	return nil, data
+}
+
+// UpdateCatalogExclusions replaces parts of the catalogue hidden from the configured app.
+func (c *VioletClient) UpdateCatalogExclusions(ctx context.Context, exclusions VioletCatalogExclusions) (error, VioletCatalogExclusions) {
+	path := fmt.Sprintf("apps/%s/catalog/exclusions", c.AppId)
+
+	body, err := json.Marshal(exclusions)
+	if err != nil {
+		return err, VioletCatalogExclusions{}
+	}
+
+	tflog.Info(ctx, "Making update catalog exclusions request", map[string]any{
+		"exclusions": string(body),
+	})
+
+	err, res := c.makeRequest(ctx, "PUT", path, body)
+
+	if err != nil {
+		tflog.Error(ctx, "Error updating catalog exclusions", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletCatalogExclusions{}
+	}
+
+	var data VioletCatalogExclusions
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing UpdateCatalogExclusions data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletCatalogExclusions{}
+	}
+
+	return nil, data
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +20,10 @@ type VioletClient struct {
 	AppSecret string
 	Token     string
 	BaseUrl   string
+	Sandbox   bool
+
+	// secretMu guards AppSecret, which changes when the secret is rotated.
+	secretMu sync.RWMutex
 }
 
 type VioletWebhook struct {
@@ -31,6 +36,46 @@ type VioletWebhook struct {
 	DateLastModified string
 }
 
+const WebhookStatusActive = "ACTIVE"
+
+// RequestError is returned when Violet responds with an error status code.
+type RequestError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("Error performing request. Response status %s. %s", e.Status, e.Body)
+}
+
+// isServerError reports whether err is a Violet response with 5xx status code, which may succeed when retried.
+func isServerError(err error) bool {
+	var requestError *RequestError
+	return errors.As(err, &requestError) && requestError.StatusCode >= http.StatusInternalServerError
+}
+
+// IsNotFound reports whether err is a Violet response with 404 status code.
+func IsNotFound(err error) bool {
+	var requestError *RequestError
+	return errors.As(err, &requestError) && requestError.StatusCode == http.StatusNotFound
+}
+
+// IsNotSupported reports whether err is a Violet response meaning the endpoint is not available.
+func IsNotSupported(err error) bool {
+	var requestError *RequestError
+	if !errors.As(err, &requestError) {
+		return false
+	}
+
+	switch requestError.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	}
+
+	return false
+}
+
 type violetWebhookResponse struct {
 	Id               int64  `json:"id"`
 	AppId            int64  `json:"app_id"`
@@ -106,6 +151,71 @@ func (c *VioletClient) GetWebhook(ctx context.Context, id int64) (error, VioletW
 	return nil, VioletWebhook(data)
 }
 
+type violetPage[T any] struct {
+	Content    []T  `json:"content"`
+	Last       bool `json:"last"`
+	TotalPages int  `json:"total_pages"`
+	Number     int  `json:"number"`
+}
+
+const listPageSize = 100
+
+func (c *VioletClient) ListWebhooks(ctx context.Context) (error, []VioletWebhook) {
+	var webhooks []VioletWebhook
+
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("apps/%s/webhooks?page=%d&size=%d", c.AppId, page, listPageSize)
+		err, res := c.makeRequest(ctx, "GET", path, nil)
+
+		if err != nil {
+			tflog.Error(ctx, "Error listing webhooks", map[string]any{
+				"page": page,
+				"err":  err.Error(),
+			})
+			return err, nil
+		}
+
+		var data violetPage[violetWebhookResponse]
+
+		err = json.Unmarshal(res, &data)
+
+		if err != nil {
+			tflog.Error(ctx, "Error parsing ListWebhooks data", map[string]any{
+				"res": string(res),
+			})
+			return err, nil
+		}
+
+		for _, webhook := range data.Content {
+			webhooks = append(webhooks, VioletWebhook(webhook))
+		}
+
+		if data.Last || len(data.Content) == 0 || page >= data.TotalPages {
+			break
+		}
+	}
+
+	return nil, webhooks
+}
+
+// FindWebhook looks up a webhook of the configured app by its event and remote endpoint.
+// The returned bool is false when no such webhook exists.
+func (c *VioletClient) FindWebhook(ctx context.Context, event string, remoteEndpoint string) (error, VioletWebhook, bool) {
+	err, webhooks := c.ListWebhooks(ctx)
+
+	if err != nil {
+		return err, VioletWebhook{}, false
+	}
+
+	for _, webhook := range webhooks {
+		if webhook.Event == event && webhook.RemoteEndpoint == remoteEndpoint {
+			return nil, webhook, true
+		}
+	}
+
+	return nil, VioletWebhook{}, false
+}
+
 type CreateWebhookInput struct {
 	Event          string
 	RemoteEndpoint string
@@ -168,13 +278,76 @@ func (c *VioletClient) DeleteWebhook(ctx context.Context, id int64) error {
 	return err
 }
 
+func (c *VioletClient) ActivateWebhook(ctx context.Context, id int64) (error, VioletWebhook) {
+	tflog.Info(ctx, "Activating webhook", map[string]any{
+		"id": id,
+	})
+
+	path := fmt.Sprintf("apps/%s/webhooks/%d/activate", c.AppId, id)
+	err, res := c.makeRequest(ctx, "PUT", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error activating webhook %d", id))
+		return err, VioletWebhook{}
+	}
+
+	var data violetWebhookResponse
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing ActivateWebhook data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletWebhook{}
+	}
+
+	return nil, VioletWebhook(data)
+}
+
+type VioletWebhookTestResult struct {
+	StatusCode int64
+}
+
+// TriggerWebhookTest asks Violet to send a sample event to the remote endpoint of the webhook.
+func (c *VioletClient) TriggerWebhookTest(ctx context.Context, id int64) (error, VioletWebhookTestResult) {
+	tflog.Info(ctx, "Triggering webhook test", map[string]any{
+		"id": id,
+	})
+
+	path := fmt.Sprintf("apps/%s/webhooks/%d/test", c.AppId, id)
+	err, res := c.makeRequest(ctx, "POST", path, nil)
+
+	if err != nil {
+		return err, VioletWebhookTestResult{}
+	}
+
+	type webhookTestResponse struct {
+		StatusCode int64 `json:"response_status_code"`
+	}
+
+	var data webhookTestResponse
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing TriggerWebhookTest data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletWebhookTestResult{}
+	}
+
+	return nil, VioletWebhookTestResult(data)
+}
+
 func (c *VioletClient) makeRequest(ctx context.Context, method string, path string, requestBody []byte) (error, []byte) {
 	tflog.Info(ctx, "Sending request to Violet", map[string]any{
 		"method": method,
 		"path":   c.BaseUrl + path,
 	})
 
-	request, err := http.NewRequest(method, c.BaseUrl+path, bytes.NewBuffer(requestBody))
+	// The request is bound to ctx, so deadlines such as resource timeouts cancel it while in flight.
+	request, err := http.NewRequestWithContext(ctx, method, c.BaseUrl+path, bytes.NewBuffer(requestBody))
 	if err != nil {
 		tflog.Error(ctx, "Error creating request", map[string]any{
 			"method": method,
@@ -185,7 +358,7 @@ func (c *VioletClient) makeRequest(ctx context.Context, method string, path stri
 
 	request.Header.Set("Content-Type", "application/json")
 	request.Header.Set("X-Violet-App-Id", c.AppId)
-	request.Header.Set("X-Violet-App-Secret", c.AppSecret)
+	request.Header.Set("X-Violet-App-Secret", c.CurrentAppSecret())
 
 	if c.Token != "" {
 		request.Header.Set("X-Violet-Token", c.Token)
@@ -206,7 +379,11 @@ func (c *VioletClient) makeRequest(ctx context.Context, method string, path stri
 	})
 
 	if response.StatusCode >= 400 {
-		return fmt.Errorf("Error performing request. Response status %s. %s", response.Status, string(body)), []byte{}
+		return &RequestError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Body:       string(body),
+		}, []byte{}
 	}
 
 	return nil, body
@@ -0,0 +1,174 @@
+package violet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VioletCollection struct {
+	Id               int64  `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	ImageUrl         string `json:"image_url"`
+	DateCreated      string `json:"date_created"`
+	DateLastModified string `json:"date_last_modified"`
+}
+
+type CollectionInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ImageUrl    string `json:"image_url"`
+}
+
+func (c *VioletClient) GetCollection(ctx context.Context, id int64) (error, VioletCollection) {
+	path := fmt.Sprintf("catalog/collections/%d", id)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting collection", map[string]any{
+			"id":  id,
+			"err": err.Error(),
+		})
+		return err, VioletCollection{}
+	}
+
+	var data VioletCollection
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetCollection data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletCollection{}
+	}
+
+	return nil, data
+}
+
+func (c *VioletClient) CreateCollection(ctx context.Context, input CollectionInput) (error, VioletCollection) {
+	return c.saveCollection(ctx, "POST", "catalog/collections", input)
+}
+
+func (c *VioletClient) UpdateCollection(ctx context.Context, id int64, input CollectionInput) (error, VioletCollection) {
+	return c.saveCollection(ctx, "PUT", fmt.Sprintf("catalog/collections/%d", id), input)
+}
+
+func (c *VioletClient) saveCollection(ctx context.Context, method string, path string, input CollectionInput) (error, VioletCollection) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err, VioletCollection{}
+	}
+
+	tflog.Info(ctx, "Making save collection request", map[string]any{
+		"method": method,
+		"name":   input.Name,
+	})
+
+	err, res := c.makeRequest(ctx, method, path, body)
+
+	if err != nil {
+		tflog.Error(ctx, "Error saving collection", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletCollection{}
+	}
+
+	var data VioletCollection
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing saved collection data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletCollection{}
+	}
+
+	return nil, data
+}
+
+func (c *VioletClient) DeleteCollection(ctx context.Context, id int64) error {
+	tflog.Info(ctx, "Deleting collection", map[string]any{
+		"id": id,
+	})
+
+	path := fmt.Sprintf("catalog/collections/%d", id)
+	err, _ := c.makeRequest(ctx, "DELETE", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting collection %d", id))
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Collection %d deleted successfully", id))
+	}
+	return err
+}
+
+// ListCollectionOffers returns ids of offers in the collection in the order they are shown.
+func (c *VioletClient) ListCollectionOffers(ctx context.Context, id int64) (error, []int64) {
+	offerIds := []int64{}
+
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("catalog/collections/%d/offers?page=%d&size=%d", id, page, listPageSize)
+		err, res := c.makeRequest(ctx, "GET", path, nil)
+
+		if err != nil {
+			tflog.Error(ctx, "Error listing collection offers", map[string]any{
+				"id":   id,
+				"page": page,
+				"err":  err.Error(),
+			})
+			return err, nil
+		}
+
+		var data violetPage[VioletOffer]
+
+		err = json.Unmarshal(res, &data)
+
+		if err != nil {
+			tflog.Error(ctx, "Error parsing ListCollectionOffers data", map[string]any{
+				"res": string(res),
+			})
+			return err, nil
+		}
+
+		for _, offer := range data.Content {
+			offerIds = append(offerIds, offer.Id)
+		}
+
+		if data.Last || len(data.Content) == 0 || page >= data.TotalPages {
+			break
+		}
+	}
+
+	return nil, offerIds
+}
+
+// SetCollectionOffers replaces offers of the collection, keeping the given order.
+func (c *VioletClient) SetCollectionOffers(ctx context.Context, id int64, offerIds []int64) error {
+	path := fmt.Sprintf("catalog/collections/%d/offers", id)
+
+	type setCollectionOffersRequest struct {
+		OfferIds []int64 `json:"offer_ids"`
+	}
+
+	body, err := json.Marshal(setCollectionOffersRequest{OfferIds: offerIds})
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Setting collection offers", map[string]any{
+		"id":        id,
+		"offer_ids": offerIds,
+	})
+
+	err, _ = c.makeRequest(ctx, "PUT", path, body)
+
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error setting offers of collection %d", id))
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+package violet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VioletCommissionRate struct {
+	AppId            int64   `json:"app_id"`
+	MerchantId       int64   `json:"merchant_id"`
+	CommissionRate   float64 `json:"commission_rate"`
+	DateLastModified string  `json:"date_last_modified"`
+}
+
+// GetCommissionRate returns the commission rate of the configured app for the merchant.
+func (c *VioletClient) GetCommissionRate(ctx context.Context, merchantId int64) (error, VioletCommissionRate) {
+	path := fmt.Sprintf("apps/%s/merchants/%d/commission_rate", c.AppId, merchantId)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting commission rate", map[string]any{
+			"merchant_id": merchantId,
+			"err":         err.Error(),
+		})
+		return err, VioletCommissionRate{}
+	}
+
+	var data VioletCommissionRate
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetCommissionRate data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletCommissionRate{}
+	}
+
+	return nil, data
+}
+
+// SetCommissionRate sets the commission rate, in percent, of the configured app for the merchant.
+func (c *VioletClient) SetCommissionRate(ctx context.Context, merchantId int64, rate float64) (error, VioletCommissionRate) {
+	path := fmt.Sprintf("apps/%s/merchants/%d/commission_rate", c.AppId, merchantId)
+	body := []byte(fmt.Sprintf(`{
+		"commission_rate": %g
+	}`, rate))
+
+	tflog.Info(ctx, "Making set commission rate request", map[string]any{
+		"merchant_id":     merchantId,
+		"commission_rate": rate,
+	})
+
+	err, res := c.makeRequest(ctx, "PUT", path, body)
+
+	if err != nil {
+		tflog.Error(ctx, "Error setting commission rate", map[string]any{
+			"merchant_id": merchantId,
+			"err":         err.Error(),
+		})
+		return err, VioletCommissionRate{}
+	}
+
+	var data VioletCommissionRate
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing SetCommissionRate data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletCommissionRate{}
+	}
+
+	return nil, data
+}
+
+type VioletGlobalCommission struct {
+	CommissionRate   float64 `json:"commission_rate"`
+	Locked           bool    `json:"commission_locked"`
+	DateLastModified string  `json:"date_last_modified"`
+}
+
+// GetGlobalCommission returns the default commission of the configured app.
+func (c *VioletClient) GetGlobalCommission(ctx context.Context) (error, VioletGlobalCommission) {
+	path := fmt.Sprintf("apps/%s/commission", c.AppId)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting global commission", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletGlobalCommission{}
+	}
+
+	var data VioletGlobalCommission
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetGlobalCommission data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletGlobalCommission{}
+	}
+
+	return nil, data
+}
+
+// UpdateGlobalCommission sets the default commission of the configured app and whether merchants can override it.
+func (c *VioletClient) UpdateGlobalCommission(ctx context.Context, commission VioletGlobalCommission) (error, VioletGlobalCommission) {
+	path := fmt.Sprintf("apps/%s/commission", c.AppId)
+	body := []byte(fmt.Sprintf(`{
+		"commission_rate": %g,
+		"commission_locked": %t
+	}`, commission.CommissionRate, commission.Locked))
+
+	tflog.Info(ctx, "Making update global commission request", map[string]any{
+		"commission_rate":   commission.CommissionRate,
+		"commission_locked": commission.Locked,
+	})
+
+	err, res := c.makeRequest(ctx, "PUT", path, body)
+
+	if err != nil {
+		tflog.Error(ctx, "Error updating global commission", map[string]any{
+			"err": err.Error(),
+		})
+		return err, VioletGlobalCommission{}
+	}
+
+	var data VioletGlobalCommission
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing UpdateGlobalCommission data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletGlobalCommission{}
+	}
+
+	return nil, data
+}
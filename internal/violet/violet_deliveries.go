@@ -0,0 +1,161 @@
+package violet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VioletWebhookDelivery struct {
+	Id              int64
+	WebhookId       int64
+	EventId         string
+	Event           string
+	StatusCode      int64
+	Attempts        int64
+	ErrorMessage    string
+	DateCreated     string
+	DateLastAttempt string
+}
+
+// Succeeded reports whether the remote endpoint accepted the delivery.
+func (d VioletWebhookDelivery) Succeeded() bool {
+	return d.StatusCode >= 200 && d.StatusCode < 300
+}
+
+type violetWebhookDeliveryResponse struct {
+	Id              int64  `json:"id"`
+	WebhookId       int64  `json:"webhook_id"`
+	EventId         string `json:"event_id"`
+	Event           string `json:"event"`
+	StatusCode      int64  `json:"response_status_code"`
+	Attempts        int64  `json:"attempts"`
+	ErrorMessage    string `json:"error_message"`
+	DateCreated     string `json:"date_created"`
+	DateLastAttempt string `json:"date_last_attempt"`
+}
+
+type ListWebhookDeliveriesInput struct {
+	// Since and Until limit deliveries to the time window, zero values mean no limit.
+	Since time.Time
+	Until time.Time
+	// Limit is the maximum number of most recent deliveries returned, zero means no limit.
+	Limit int
+	// Match filters deliveries when not nil. Limit counts only matching deliveries, so paging stops
+	// as soon as enough of them are found.
+	Match func(VioletWebhookDelivery) bool
+}
+
+// ListWebhookDeliveries returns delivery attempts of the webhook, most recent first.
+func (c *VioletClient) ListWebhookDeliveries(ctx context.Context, webhookId int64, input ListWebhookDeliveriesInput) (error, []VioletWebhookDelivery) {
+	var deliveries []VioletWebhookDelivery
+
+	query := url.Values{}
+	query.Set("size", strconv.Itoa(listPageSize))
+	query.Set("sort", "date_created,desc")
+	if !input.Since.IsZero() {
+		query.Set("start_date", input.Since.UTC().Format(time.RFC3339))
+	}
+	if !input.Until.IsZero() {
+		query.Set("end_date", input.Until.UTC().Format(time.RFC3339))
+	}
+
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("events/webhooks/%d/deliveries?%s", webhookId, query.Encode())
+		err, res := c.makeRequest(ctx, "GET", path, nil)
+
+		if err != nil {
+			tflog.Error(ctx, "Error listing webhook deliveries", map[string]any{
+				"id":   webhookId,
+				"page": page,
+				"err":  err.Error(),
+			})
+			return err, nil
+		}
+
+		var data violetPage[violetWebhookDeliveryResponse]
+
+		err = json.Unmarshal(res, &data)
+
+		if err != nil {
+			tflog.Error(ctx, "Error parsing ListWebhookDeliveries data", map[string]any{
+				"res": string(res),
+			})
+			return err, nil
+		}
+
+		for _, response := range data.Content {
+			delivery := VioletWebhookDelivery(response)
+			if input.Match != nil && !input.Match(delivery) {
+				continue
+			}
+
+			deliveries = append(deliveries, delivery)
+
+			if input.Limit > 0 && len(deliveries) >= input.Limit {
+				return nil, deliveries
+			}
+		}
+
+		if data.Last || len(data.Content) == 0 || page >= data.TotalPages {
+			break
+		}
+	}
+
+	return nil, deliveries
+}
+
+type VioletWebhookHealth struct {
+	Deliveries          int64
+	FailedDeliveries    int64
+	FailureRate         float64
+	ConsecutiveFailures int64
+	LastSuccess         string
+	LastFailure         string
+}
+
+// WebhookHealth summarizes deliveries sorted most recent first, as returned by ListWebhookDeliveries.
+func WebhookHealth(deliveries []VioletWebhookDelivery) VioletWebhookHealth {
+	health := VioletWebhookHealth{
+		Deliveries: int64(len(deliveries)),
+	}
+	consecutive := true
+
+	for _, delivery := range deliveries {
+		if delivery.Succeeded() {
+			consecutive = false
+			if health.LastSuccess == "" {
+				health.LastSuccess = lastAttempt(delivery)
+			}
+			continue
+		}
+
+		health.FailedDeliveries++
+		if consecutive {
+			health.ConsecutiveFailures++
+		}
+		if health.LastFailure == "" {
+			health.LastFailure = lastAttempt(delivery)
+		}
+	}
+
+	if health.Deliveries > 0 {
+		health.FailureRate = float64(health.FailedDeliveries) / float64(health.Deliveries)
+	}
+
+	return health
+}
+
+func lastAttempt(delivery VioletWebhookDelivery) string {
+	if delivery.DateLastAttempt != "" {
+		return delivery.DateLastAttempt
+	}
+
+	return delivery.DateCreated
+}
@@ -0,0 +1,43 @@
+package violet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListWebhookDeliveriesStopsAtMatchingLimit(t *testing.T) {
+	requests := 0
+
+	// Every page holds one failed and one successful delivery, out of many pages.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{"content": [
+			{"id": %[1]s1, "response_status_code": 500},
+			{"id": %[1]s2, "response_status_code": 200}
+		], "last": false, "total_pages": 50}`, page)
+	}))
+	defer server.Close()
+
+	client := &VioletClient{AppId: "10099", BaseUrl: server.URL + "/"}
+
+	err, deliveries := client.ListWebhookDeliveries(context.Background(), 2214, ListWebhookDeliveriesInput{
+		Limit: 2,
+		Match: func(delivery VioletWebhookDelivery) bool {
+			return !delivery.Succeeded()
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deliveries) != 2 || deliveries[0].Id != 11 || deliveries[1].Id != 21 {
+		t.Errorf("expected failed deliveries 11 and 21, got %+v", deliveries)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,98 @@
+package violet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VioletMerchant struct {
+	Id               int64
+	Name             string
+	StoreUrl         string
+	Platform         string
+	Status           string
+	ConnectionStatus string
+	Currency         string
+	CountryCode      string
+	DateCreated      string
+}
+
+type violetMerchantResponse struct {
+	Id               int64  `json:"id"`
+	Name             string `json:"merchant_name"`
+	StoreUrl         string `json:"store_url"`
+	Platform         string `json:"source"`
+	Status           string `json:"status"`
+	ConnectionStatus string `json:"connection_status"`
+	Currency         string `json:"default_currency"`
+	CountryCode      string `json:"country_code"`
+	DateCreated      string `json:"date_created"`
+}
+
+func (c *VioletClient) GetMerchant(ctx context.Context, id int64) (error, VioletMerchant) {
+	path := fmt.Sprintf("merchants/%d", id)
+	err, res := c.makeRequest(ctx, "GET", path, nil)
+
+	if err != nil {
+		tflog.Error(ctx, "Error getting merchant", map[string]any{
+			"id":  id,
+			"err": err.Error(),
+		})
+		return err, VioletMerchant{}
+	}
+
+	var data violetMerchantResponse
+
+	err = json.Unmarshal(res, &data)
+
+	if err != nil {
+		tflog.Error(ctx, "Error parsing GetMerchant data", map[string]any{
+			"res": string(res),
+		})
+		return err, VioletMerchant{}
+	}
+
+	return nil, VioletMerchant(data)
+}
+
+// ListMerchants returns all merchants connected to the app, going through every page.
+func (c *VioletClient) ListMerchants(ctx context.Context) (error, []VioletMerchant) {
+	var merchants []VioletMerchant
+
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("merchants?page=%d&size=%d", page, listPageSize)
+		err, res := c.makeRequest(ctx, "GET", path, nil)
+
+		if err != nil {
+			tflog.Error(ctx, "Error listing merchants", map[string]any{
+				"page": page,
+				"err":  err.Error(),
+			})
+			return err, nil
+		}
+
+		var data violetPage[violetMerchantResponse]
+
+		err = json.Unmarshal(res, &data)
+
+		if err != nil {
+			tflog.Error(ctx, "Error parsing ListMerchants data", map[string]any{
+				"res": string(res),
+			})
+			return err, nil
+		}
+
+		for _, merchant := range data.Content {
+			merchants = append(merchants, VioletMerchant(merchant))
+		}
+
+		if data.Last || len(data.Content) == 0 || page >= data.TotalPages {
+			break
+		}
+	}
+
+	return nil, merchants
+}
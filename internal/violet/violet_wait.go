@@ -0,0 +1,92 @@
+package violet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	pollMinInterval = time.Second
+	pollMaxInterval = 30 * time.Second
+)
+
+// poll calls check with exponential backoff until it reports done, returns an error or ctx is done.
+// Server errors are retried, so a single failed request does not end the wait.
+func poll(ctx context.Context, check func() (bool, error)) error {
+	interval := pollMinInterval
+	var lastErr error
+
+	for {
+		done, err := check()
+		if isServerError(err) {
+			tflog.Warn(ctx, "Retrying after Violet server error", map[string]any{
+				"err": err.Error(),
+			})
+			lastErr = err
+		} else if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("%w, last error: %s", ctx.Err(), lastErr.Error())
+			}
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > pollMaxInterval {
+			interval = pollMaxInterval
+		}
+	}
+}
+
+// WaitForWebhookStatus polls the webhook until it reaches the given status or ctx is done.
+func (c *VioletClient) WaitForWebhookStatus(ctx context.Context, id int64, status string) (error, VioletWebhook) {
+	var webhook VioletWebhook
+
+	err := poll(ctx, func() (bool, error) {
+		var err error
+		err, webhook = c.GetWebhook(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		tflog.Info(ctx, "Waiting for webhook status", map[string]any{
+			"id":      id,
+			"status":  webhook.Status,
+			"desired": status,
+		})
+
+		return webhook.Status == status, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for webhook %d to reach status %s (last status %q): %w", id, status, webhook.Status, err), webhook
+	}
+
+	return nil, webhook
+}
+
+// WaitForWebhookDeleted polls the webhook until Violet no longer finds it or ctx is done.
+func (c *VioletClient) WaitForWebhookDeleted(ctx context.Context, id int64) error {
+	err := poll(ctx, func() (bool, error) {
+		err, _ := c.GetWebhook(ctx, id)
+		if IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for webhook %d to be deleted: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package violet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForWebhookStatusRetriesServerErrors(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 2214, "event": "ORDER_UPDATED", "status": "ACTIVE"}`)
+	}))
+	defer server.Close()
+
+	client := &VioletClient{AppId: "10099", BaseUrl: server.URL + "/"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err, webhook := client.WaitForWebhookStatus(ctx, 2214, WebhookStatusActive)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if webhook.Status != WebhookStatusActive || requests != 2 {
+		t.Errorf("expected ACTIVE after 2 requests, got %q after %d", webhook.Status, requests)
+	}
+}
+
+func TestWaitForWebhookStatusCancelsHangingRequest(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &VioletClient{AppId: "10099", BaseUrl: server.URL + "/"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err, _ := client.WaitForWebhookStatus(ctx, 2214, WebhookStatusActive)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected wait to stop at the deadline, took %s", elapsed)
+	}
+}
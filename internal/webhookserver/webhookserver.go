@@ -0,0 +1,203 @@
+// Package webhookserver implements the serve-webhooks mode of the provider binary, a local receiver
+// printing webhook deliveries sent by Violet.
+package webhookserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rutkowskib/terraform-provider-violet/webhook"
+)
+
+// Command is the name of the subcommand starting the server.
+const Command = "serve-webhooks"
+
+type record struct {
+	ReceivedAt time.Time           `json:"received_at"`
+	Event      string              `json:"event,omitempty"`
+	WebhookId  int64               `json:"webhook_id,omitempty"`
+	EventId    string              `json:"event_id,omitempty"`
+	EntityId   string              `json:"entity_id,omitempty"`
+	Verified   bool                `json:"verified"`
+	Error      string              `json:"error,omitempty"`
+	Status     int                 `json:"status"`
+	Headers    map[string][]string `json:"headers"`
+	Body       json.RawMessage     `json:"body,omitempty"`
+	RawBody    string              `json:"raw_body,omitempty"`
+}
+
+type server struct {
+	verifier *webhook.Verifier
+	statuses []int
+	out      io.Writer
+	pretty   bool
+
+	mu         sync.Mutex
+	deliveries int
+}
+
+// Run parses args of the serve-webhooks command and serves until interrupted.
+func Run(args []string) error {
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	// The secret is not used as the flag default, so usage text never prints it.
+	secret := flags.String("secret", "", "Violet App Secret used to verify signatures, defaults to VIOLET_APP_SECRET environment variable")
+	out := flags.String("out", "", "file to append deliveries to as JSON lines, deliveries are pretty printed to stdout when empty")
+	statuses := flags.String("status", "200", "comma separated status codes to respond with, used in turn for consecutive deliveries")
+	tolerance := flags.Duration("tolerance", webhook.DefaultTolerance, "maximum age of a delivery, negative disables the check")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if *secret == "" {
+		*secret = os.Getenv("VIOLET_APP_SECRET")
+	}
+
+	if *secret == "" {
+		return errors.New("app secret is required, use -secret flag or VIOLET_APP_SECRET environment variable")
+	}
+
+	s := &server{
+		verifier: &webhook.Verifier{
+			Secret:    *secret,
+			Tolerance: *tolerance,
+		},
+		out:    os.Stdout,
+		pretty: true,
+	}
+
+	for _, status := range strings.Split(*statuses, ",") {
+		code, err := strconv.Atoi(strings.TrimSpace(status))
+		if err != nil || code < 100 || code > 599 {
+			return fmt.Errorf("invalid status code %q", status)
+		}
+		s.statuses = append(s.statuses, code)
+	}
+
+	if *out != "" {
+		file, err := os.OpenFile(*out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		s.out = file
+		s.pretty = false
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("Listening for Violet webhooks on %s", *addr)
+
+	if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// ServeHTTP records the delivery and responds with the next configured status code.
+// Deliveries that fail verification are recorded too and rejected with their own status code.
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec := record{
+		ReceivedAt: time.Now().UTC(),
+		Headers:    r.Header,
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if json.Valid(body) {
+		rec.Body = body
+	} else {
+		rec.RawBody = string(body)
+	}
+
+	delivery, err := s.verifier.VerifyBody(r.Header, body)
+
+	if err != nil {
+		rec.Error = err.Error()
+		rec.Status = http.StatusBadRequest
+		if errors.Is(err, webhook.ErrInvalidSignature) || r.Header.Get(webhook.HeaderHmac) == "" {
+			rec.Status = http.StatusUnauthorized
+		}
+		rec.Event = r.Header.Get(webhook.HeaderTopic)
+	} else {
+		rec.Verified = true
+		rec.Event = string(delivery.Event)
+		rec.WebhookId = delivery.WebhookId
+		rec.EventId = delivery.EventId
+		rec.EntityId = delivery.EntityId
+		rec.Status = s.nextStatus()
+	}
+
+	s.write(rec)
+
+	w.WriteHeader(rec.Status)
+}
+
+func (s *server) nextStatus() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.statuses[s.deliveries%len(s.statuses)]
+	s.deliveries++
+
+	return status
+}
+
+func (s *server) write(rec record) {
+	var data []byte
+	var err error
+
+	if s.pretty {
+		data, err = json.MarshalIndent(rec, "", "  ")
+	} else {
+		data, err = json.Marshal(rec)
+	}
+
+	if err != nil {
+		log.Printf("Error encoding delivery: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.out.Write(append(data, '\n')); err != nil {
+		log.Printf("Error writing delivery: %s", err)
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/rutkowskib/terraform-provider-violet/internal/provider"
+	"github.com/rutkowskib/terraform-provider-violet/internal/webhookserver"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -32,6 +34,14 @@ var (
 )
 
 func main() {
+	// Run "terraform-provider-violet serve-webhooks -help" for options of the local webhook receiver.
+	if len(os.Args) > 1 && os.Args[1] == webhookserver.Command {
+		if err := webhookserver.Run(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
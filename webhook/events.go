@@ -0,0 +1,121 @@
+// Package webhook verifies and decodes webhook deliveries sent by Violet,
+// for services receiving webhooks managed with the violet_webhook resource.
+//
+// The HMAC signature covers only the body. The X-Violet-Timestamp header is not signed, so the
+// tolerance check alone does not stop a captured delivery from being replayed with a fresh timestamp.
+// Set Verifier.Seen, e.g. to a MemoryEventIdStore, to reject deliveries of an event id that was
+// already accepted.
+package webhook
+
+// Event is the type of webhook event, the same value as event of violet_webhook.
+type Event string
+
+const (
+	EventOrderAccepted  Event = "ORDER_ACCEPTED"
+	EventOrderUpdated   Event = "ORDER_UPDATED"
+	EventOrderCompleted Event = "ORDER_COMPLETED"
+	EventOrderCanceled  Event = "ORDER_CANCELED"
+	EventOrderRefunded  Event = "ORDER_REFUNDED"
+	EventOrderReturned  Event = "ORDER_RETURNED"
+	EventOrderFailed    Event = "ORDER_FAILED"
+
+	EventBagSubmitted Event = "BAG_SUBMITTED"
+	EventBagAccepted  Event = "BAG_ACCEPTED"
+	EventBagShipped   Event = "BAG_SHIPPED"
+	EventBagCompleted Event = "BAG_COMPLETED"
+	EventBagCanceled  Event = "BAG_CANCELED"
+	EventBagRefunded  Event = "BAG_REFUNDED"
+
+	EventOfferAdded   Event = "OFFER_ADDED"
+	EventOfferUpdated Event = "OFFER_UPDATED"
+	EventOfferRemoved Event = "OFFER_REMOVED"
+	EventOfferDeleted Event = "OFFER_DELETED"
+
+	EventMerchantConnected    Event = "MERCHANT_CONNECTED"
+	EventMerchantDisconnected Event = "MERCHANT_DISCONNECTED"
+	EventMerchantEnabled      Event = "MERCHANT_ENABLED"
+	EventMerchantDisabled     Event = "MERCHANT_DISABLED"
+
+	EventCollectionCreated       Event = "COLLECTION_CREATED"
+	EventCollectionUpdated       Event = "COLLECTION_UPDATED"
+	EventCollectionRemoved       Event = "COLLECTION_REMOVED"
+	EventCollectionOffersUpdated Event = "COLLECTION_OFFERS_UPDATED"
+
+	EventTransferSent   Event = "TRANSFER_SENT"
+	EventTransferFailed Event = "TRANSFER_FAILED"
+)
+
+// newPayload creates an empty payload of the type sent with each event.
+var newPayload = map[Event]func() any{
+	EventOrderAccepted:  func() any { return &Order{} },
+	EventOrderUpdated:   func() any { return &Order{} },
+	EventOrderCompleted: func() any { return &Order{} },
+	EventOrderCanceled:  func() any { return &Order{} },
+	EventOrderRefunded:  func() any { return &Order{} },
+	EventOrderReturned:  func() any { return &Order{} },
+	EventOrderFailed:    func() any { return &Order{} },
+
+	EventBagSubmitted: func() any { return &Bag{} },
+	EventBagAccepted:  func() any { return &Bag{} },
+	EventBagShipped:   func() any { return &Bag{} },
+	EventBagCompleted: func() any { return &Bag{} },
+	EventBagCanceled:  func() any { return &Bag{} },
+	EventBagRefunded:  func() any { return &Bag{} },
+
+	EventOfferAdded:   func() any { return &Offer{} },
+	EventOfferUpdated: func() any { return &Offer{} },
+	EventOfferRemoved: func() any { return &Offer{} },
+	EventOfferDeleted: func() any { return &Offer{} },
+
+	EventMerchantConnected:    func() any { return &Merchant{} },
+	EventMerchantDisconnected: func() any { return &Merchant{} },
+	EventMerchantEnabled:      func() any { return &Merchant{} },
+	EventMerchantDisabled:     func() any { return &Merchant{} },
+
+	EventCollectionCreated:       func() any { return &Collection{} },
+	EventCollectionUpdated:       func() any { return &Collection{} },
+	EventCollectionRemoved:       func() any { return &Collection{} },
+	EventCollectionOffersUpdated: func() any { return &Collection{} },
+
+	EventTransferSent:   func() any { return &Transfer{} },
+	EventTransferFailed: func() any { return &Transfer{} },
+}
+
+// Events returns all events in the catalogue, in the order they are declared.
+func Events() []Event {
+	return []Event{
+		EventOrderAccepted,
+		EventOrderUpdated,
+		EventOrderCompleted,
+		EventOrderCanceled,
+		EventOrderRefunded,
+		EventOrderReturned,
+		EventOrderFailed,
+		EventBagSubmitted,
+		EventBagAccepted,
+		EventBagShipped,
+		EventBagCompleted,
+		EventBagCanceled,
+		EventBagRefunded,
+		EventOfferAdded,
+		EventOfferUpdated,
+		EventOfferRemoved,
+		EventOfferDeleted,
+		EventMerchantConnected,
+		EventMerchantDisconnected,
+		EventMerchantEnabled,
+		EventMerchantDisabled,
+		EventCollectionCreated,
+		EventCollectionUpdated,
+		EventCollectionRemoved,
+		EventCollectionOffersUpdated,
+		EventTransferSent,
+		EventTransferFailed,
+	}
+}
+
+// Known reports whether the event is in the catalogue.
+func (e Event) Known() bool {
+	_, ok := newPayload[e]
+	return ok
+}
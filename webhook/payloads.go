@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Order is the payload of ORDER_* events.
+type Order struct {
+	Id               int64  `json:"id"`
+	AppId            int64  `json:"app_id"`
+	AppOrderId       string `json:"app_order_id,omitempty"`
+	Status           string `json:"status"`
+	Currency         string `json:"currency"`
+	Subtotal         int64  `json:"sub_total"`
+	ShippingTotal    int64  `json:"shipping_total"`
+	TaxTotal         int64  `json:"tax_total"`
+	Total            int64  `json:"total"`
+	Bags             []Bag  `json:"bags"`
+	DateCreated      string `json:"date_created"`
+	DateLastModified string `json:"date_last_modified"`
+}
+
+// Bag is the payload of BAG_* events. A bag holds items of an order from a single merchant.
+type Bag struct {
+	Id                int64      `json:"id"`
+	OrderId           int64      `json:"order_id"`
+	MerchantId        int64      `json:"merchant_id"`
+	MerchantName      string     `json:"merchant_name"`
+	Status            string     `json:"status"`
+	FulfillmentStatus string     `json:"fulfillment_status"`
+	FinancialStatus   string     `json:"financial_status"`
+	Currency          string     `json:"currency"`
+	Subtotal          int64      `json:"sub_total"`
+	ShippingTotal     int64      `json:"shipping_total"`
+	TaxTotal          int64      `json:"tax_total"`
+	Total             int64      `json:"total"`
+	Skus              []OrderSku `json:"skus"`
+	DateCreated       string     `json:"date_created"`
+	DateLastModified  string     `json:"date_last_modified"`
+}
+
+// OrderSku is a single ordered item of a bag.
+type OrderSku struct {
+	Id       int64  `json:"id"`
+	SkuId    int64  `json:"sku_id"`
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity"`
+	Price    int64  `json:"price"`
+	Status   string `json:"status"`
+}
+
+// Offer is the payload of OFFER_* events.
+type Offer struct {
+	Id               int64  `json:"id"`
+	ProductId        string `json:"product_id"`
+	MerchantId       int64  `json:"merchant_id"`
+	Name             string `json:"name"`
+	Source           string `json:"source"`
+	Currency         string `json:"currency"`
+	MinPrice         int64  `json:"min_price"`
+	MaxPrice         int64  `json:"max_price"`
+	Available        bool   `json:"available"`
+	Visible          bool   `json:"visible"`
+	Status           string `json:"status"`
+	DateCreated      string `json:"date_created"`
+	DateLastModified string `json:"date_last_modified"`
+}
+
+// Merchant is the payload of MERCHANT_* events.
+type Merchant struct {
+	Id               int64  `json:"id"`
+	Name             string `json:"merchant_name"`
+	Platform         string `json:"platform"`
+	StoreUrl         string `json:"store_url"`
+	Status           string `json:"status"`
+	DefaultCurrency  string `json:"default_currency"`
+	DateCreated      string `json:"date_created"`
+	DateLastModified string `json:"date_last_modified"`
+}
+
+// Collection is the payload of COLLECTION_* events.
+type Collection struct {
+	Id               int64  `json:"id"`
+	MerchantId       int64  `json:"merchant_id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Type             string `json:"type"`
+	Status           string `json:"status"`
+	DateCreated      string `json:"date_created"`
+	DateLastModified string `json:"date_last_modified"`
+}
+
+// Transfer is the payload of TRANSFER_* events.
+type Transfer struct {
+	Id               int64   `json:"id"`
+	MerchantId       int64   `json:"merchant_id"`
+	OrderIds         []int64 `json:"related_orders"`
+	Status           string  `json:"status"`
+	Currency         string  `json:"currency"`
+	Amount           int64   `json:"amount"`
+	Errors           []any   `json:"errors,omitempty"`
+	DateCreated      string  `json:"date_created"`
+	DateLastModified string  `json:"date_last_modified"`
+}
+
+// DecodePayload decodes the body of a delivery of the given event into its typed payload,
+// e.g. *Order for ORDER_UPDATED.
+func DecodePayload(event Event, body []byte) (any, error) {
+	factory, ok := newPayload[event]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, event)
+	}
+
+	payload := factory()
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, fmt.Errorf("decoding %s payload: %w", event, err)
+	}
+
+	return payload, nil
+}
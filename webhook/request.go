@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// NewRequest builds a delivery to url signed with the app secret, with the same headers Violet sends.
+// It is meant for testing receivers without waiting for Violet to send real events.
+func NewRequest(ctx context.Context, url string, event Event, webhookId int64, body []byte, secret string) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range Headers(event, webhookId, body, secret, time.Now()) {
+		request.Header.Set(name, value)
+	}
+
+	return request, nil
+}
+
+// Headers returns headers Violet sends with a delivery of body, including its signature.
+func Headers(event Event, webhookId int64, body []byte, secret string, timestamp time.Time) map[string]string {
+	return map[string]string{
+		"Content-Type":  "application/json",
+		HeaderHmac:      Sign(body, secret),
+		HeaderTopic:     string(event),
+		HeaderWebhookId: strconv.FormatInt(webhookId, 10),
+		HeaderEventId:   strconv.FormatInt(timestamp.UnixNano(), 10),
+		HeaderTimestamp: strconv.FormatInt(timestamp.Unix(), 10),
+	}
+}
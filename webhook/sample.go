@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SampleVersion is the version of sample payloads returned by SamplePayload. It changes whenever
+// samples change in a way that could break fixtures generated from them.
+const SampleVersion = "v1"
+
+//go:embed samples/v1/*.json
+var samples embed.FS
+
+// SamplePayload returns an example body of a delivery of the event.
+func SamplePayload(event Event) ([]byte, error) {
+	if !event.Known() {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, event)
+	}
+
+	return samples.ReadFile(fmt.Sprintf("samples/%s/%s.json", SampleVersion, event))
+}
+
+// SampleTimestamp returns date_created of the example body of the event. It is a fixed time, so deliveries
+// built from samples, including their signatures, do not change between runs.
+func SampleTimestamp(event Event) (time.Time, error) {
+	body, err := SamplePayload(event)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var sample struct {
+		DateCreated string `json:"date_created"`
+	}
+	if err := json.Unmarshal(body, &sample); err != nil {
+		return time.Time{}, fmt.Errorf("decoding %s sample: %w", event, err)
+	}
+
+	return time.Parse("2006-01-02T15:04:05-0700", sample.DateCreated)
+}
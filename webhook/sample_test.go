@@ -0,0 +1,17 @@
+package webhook
+
+import "testing"
+
+func TestSampleTimestamp(t *testing.T) {
+	for _, event := range Events() {
+		t.Run(string(event), func(t *testing.T) {
+			timestamp, err := SampleTimestamp(event)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if timestamp.IsZero() {
+				t.Error("expected sample timestamp to be set")
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"embed"
+	"fmt"
+)
+
+// SchemaVersion is the version of JSON Schemas returned by PayloadSchema.
+const SchemaVersion = "v1"
+
+//go:embed schemas/v1/*.json
+var schemas embed.FS
+
+// PayloadSchema returns JSON Schema (draft 2020-12) of the body of deliveries of the event.
+// Events sharing a payload type, e.g. all ORDER_* events, share the schema.
+func PayloadSchema(event Event) ([]byte, error) {
+	name := payloadName(event)
+	if name == "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, event)
+	}
+
+	return schemas.ReadFile(fmt.Sprintf("schemas/%s/%s.json", SchemaVersion, name))
+}
+
+// payloadName returns name of the payload type of the event, empty for unknown events.
+func payloadName(event Event) string {
+	factory, ok := newPayload[event]
+	if !ok {
+		return ""
+	}
+
+	switch factory().(type) {
+	case *Order:
+		return "order"
+	case *Bag:
+		return "bag"
+	case *Offer:
+		return "offer"
+	case *Merchant:
+		return "merchant"
+	case *Collection:
+		return "collection"
+	case *Transfer:
+		return "transfer"
+	}
+
+	return ""
+}
@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// Sign computes the signature Violet sends with a webhook delivery: base64 encoded HMAC-SHA256
+// of the request body, keyed with the app secret. It is also useful for building test deliveries.
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is a valid signature of body for the given app secret.
+func VerifySignature(body []byte, secret string, signature string) bool {
+	return hmac.Equal([]byte(Sign(body, secret)), []byte(signature))
+}
@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Headers Violet sends with every webhook delivery.
+const (
+	HeaderHmac      = "X-Violet-Hmac"
+	HeaderTopic     = "X-Violet-Topic"
+	HeaderWebhookId = "X-Violet-Webhook-Id"
+	HeaderEventId   = "X-Violet-Event-Id"
+	HeaderEntityId  = "X-Violet-Entity-Id"
+	HeaderTimestamp = "X-Violet-Timestamp"
+)
+
+// DefaultTolerance is the maximum age of a delivery accepted by Verify.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrMissingSecret      = errors.New("webhook app secret is empty")
+	ErrMissingHeader      = errors.New("missing webhook header")
+	ErrInvalidSignature   = errors.New("invalid webhook signature")
+	ErrOutsideTolerance   = errors.New("webhook timestamp outside of tolerance")
+	ErrUnknownEvent       = errors.New("unknown webhook event")
+	ErrInvalidHeaderValue = errors.New("invalid webhook header value")
+	ErrReplayedDelivery   = errors.New("webhook event already delivered")
+)
+
+// Delivery is a verified webhook delivery.
+type Delivery struct {
+	Event     Event
+	WebhookId int64
+	EventId   string
+	EntityId  string
+	Timestamp time.Time
+	Body      []byte
+	// Payload is the decoded body, e.g. *Order for ORDER_UPDATED, or json.RawMessage for unknown events.
+	Payload any
+}
+
+// Verifier verifies webhook deliveries signed with the app secret.
+type Verifier struct {
+	// Secret is the Violet App Secret.
+	Secret string
+	// Tolerance is the maximum difference between the delivery timestamp and current time.
+	// Zero means DefaultTolerance, negative disables the check.
+	Tolerance time.Duration
+	// Now returns current time, time.Now when nil.
+	Now func() time.Time
+	// Seen rejects deliveries with an event id that was already accepted, when not nil.
+	// The timestamp is not signed, so the tolerance alone does not stop replays of a captured delivery.
+	Seen EventIdStore
+}
+
+// EventIdStore remembers event ids of accepted deliveries.
+type EventIdStore interface {
+	// Seen records the event id and reports whether it was recorded before.
+	Seen(eventId string) bool
+}
+
+// MemoryEventIdStore is an EventIdStore keeping event ids in memory for the given retention.
+// Ids older than the retention are forgotten, so it should be longer than the tolerance of the Verifier.
+type MemoryEventIdStore struct {
+	// Retention is how long event ids are remembered. Zero means DefaultTolerance.
+	Retention time.Duration
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewMemoryEventIdStore returns a store remembering event ids for the given retention, zero means DefaultTolerance.
+func NewMemoryEventIdStore(retention time.Duration) *MemoryEventIdStore {
+	return &MemoryEventIdStore{Retention: retention}
+}
+
+func (s *MemoryEventIdStore) Seen(eventId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	retention := s.Retention
+	if retention <= 0 {
+		retention = DefaultTolerance
+	}
+
+	now := time.Now()
+	if s.seen == nil {
+		s.seen = map[string]time.Time{}
+	}
+	for id, at := range s.seen {
+		if now.Sub(at) > retention {
+			delete(s.seen, id)
+		}
+	}
+
+	if _, ok := s.seen[eventId]; ok {
+		return true
+	}
+	s.seen[eventId] = now
+	return false
+}
+
+// Verify reads the request body and checks its signature and headers. The body of the request
+// is replaced, so it can be read again by the next handler.
+func (v *Verifier) Verify(r *http.Request) (*Delivery, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading webhook body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return v.VerifyBody(r.Header, body)
+}
+
+// VerifyBody checks signature and headers of a delivery that has already been read.
+func (v *Verifier) VerifyBody(header http.Header, body []byte) (*Delivery, error) {
+	var err error
+
+	// Anyone can sign a body with an empty secret, so such deliveries are never accepted.
+	if v.Secret == "" {
+		return nil, ErrMissingSecret
+	}
+
+	signature := header.Get(HeaderHmac)
+	if signature == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderHmac)
+	}
+
+	if !VerifySignature(body, v.Secret, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	topic := header.Get(HeaderTopic)
+	if topic == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderTopic)
+	}
+
+	delivery := &Delivery{
+		Event:    Event(topic),
+		EventId:  header.Get(HeaderEventId),
+		EntityId: header.Get(HeaderEntityId),
+		Body:     body,
+	}
+
+	if webhookId := header.Get(HeaderWebhookId); webhookId != "" {
+		delivery.WebhookId, err = strconv.ParseInt(webhookId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %q", ErrInvalidHeaderValue, HeaderWebhookId, webhookId)
+		}
+	}
+
+	if v.Tolerance >= 0 {
+		delivery.Timestamp, err = parseTimestamp(header.Get(HeaderTimestamp))
+		if err != nil {
+			return nil, err
+		}
+
+		tolerance := v.Tolerance
+		if tolerance == 0 {
+			tolerance = DefaultTolerance
+		}
+
+		now := time.Now
+		if v.Now != nil {
+			now = v.Now
+		}
+
+		age := now().Sub(delivery.Timestamp)
+		if age > tolerance || age < -tolerance {
+			return nil, fmt.Errorf("%w: delivery sent at %s", ErrOutsideTolerance, delivery.Timestamp.Format(time.RFC3339))
+		}
+	}
+
+	// Events missing from the catalogue are passed on undecoded, so receivers keep working
+	// when Violet adds new events.
+	delivery.Payload, err = DecodePayload(delivery.Event, body)
+	if errors.Is(err, ErrUnknownEvent) {
+		delivery.Payload = json.RawMessage(body)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if v.Seen != nil {
+		if delivery.EventId == "" {
+			return nil, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderEventId)
+		}
+		if v.Seen.Seen(delivery.EventId) {
+			return nil, fmt.Errorf("%w: %s", ErrReplayedDelivery, delivery.EventId)
+		}
+	}
+
+	return delivery, nil
+}
+
+// parseTimestamp accepts unix seconds, unix milliseconds or RFC 3339 timestamps.
+func parseTimestamp(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrMissingHeader, HeaderTimestamp)
+	}
+
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if unix > 1e12 {
+			return time.UnixMilli(unix), nil
+		}
+		return time.Unix(unix, 0), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s: %q", ErrInvalidHeaderValue, HeaderTimestamp, value)
+	}
+
+	return timestamp, nil
+}
+
+type deliveryContextKey struct{}
+
+// DeliveryFromContext returns the delivery verified by Middleware.
+func DeliveryFromContext(ctx context.Context) (*Delivery, bool) {
+	delivery, ok := ctx.Value(deliveryContextKey{}).(*Delivery)
+	return delivery, ok
+}
+
+// Middleware verifies deliveries before passing them to next. Deliveries with invalid signature are
+// rejected with 401 status and other invalid deliveries with 400. The verified delivery is available
+// to next through DeliveryFromContext. It panics when Secret is empty.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	if v.Secret == "" {
+		panic("webhook: Middleware requires a non-empty app secret")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivery, err := v.Verify(r)
+
+		if err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, ErrInvalidSignature) || r.Header.Get(HeaderHmac) == "" {
+				status = http.StatusUnauthorized
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), deliveryContextKey{}, delivery)))
+	})
+}
+
+// Middleware verifies deliveries with the given app secret and default tolerance. See Verifier.Middleware.
+// It panics when secret is empty.
+func Middleware(secret string) func(http.Handler) http.Handler {
+	if secret == "" {
+		panic("webhook: Middleware requires a non-empty app secret")
+	}
+
+	verifier := &Verifier{Secret: secret}
+	return verifier.Middleware
+}
@@ -0,0 +1,351 @@
+package webhook
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-app-secret"
+
+var testNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func testDelivery(t *testing.T, timestamp time.Time) (http.Header, []byte) {
+	t.Helper()
+
+	body := []byte(`{"id": 10001, "app_id": 10099, "status": "ACCEPTED", "currency": "USD", "total": 2500}`)
+
+	header := http.Header{}
+	header.Set(HeaderHmac, Sign(body, testSecret))
+	header.Set(HeaderTopic, string(EventOrderUpdated))
+	header.Set(HeaderWebhookId, "2214")
+	header.Set(HeaderEventId, "3f1c2a9e")
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+
+	return header, body
+}
+
+func TestVerifyBody(t *testing.T) {
+	tests := map[string]struct {
+		verifier    Verifier
+		emptySecret bool
+		modify      func(header http.Header, body []byte) []byte
+		wantErr     error
+	}{
+		"valid signature": {},
+		"bad signature": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderHmac, Sign(body, "other-secret"))
+				return body
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		"modified body": {
+			modify: func(header http.Header, body []byte) []byte {
+				return append(body, ' ')
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		"missing signature header": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Del(HeaderHmac)
+				return body
+			},
+			wantErr: ErrMissingHeader,
+		},
+		"missing topic header": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Del(HeaderTopic)
+				return body
+			},
+			wantErr: ErrMissingHeader,
+		},
+		"missing timestamp header": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Del(HeaderTimestamp)
+				return body
+			},
+			wantErr: ErrMissingHeader,
+		},
+		"invalid webhook id": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderWebhookId, "abc")
+				return body
+			},
+			wantErr: ErrInvalidHeaderValue,
+		},
+		"empty secret": {
+			emptySecret: true,
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderHmac, Sign(body, ""))
+				return body
+			},
+			wantErr: ErrMissingSecret,
+		},
+		"timestamp at tolerance": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(-DefaultTolerance).Unix(), 10))
+				return body
+			},
+		},
+		"timestamp past tolerance": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(-DefaultTolerance-time.Second).Unix(), 10))
+				return body
+			},
+			wantErr: ErrOutsideTolerance,
+		},
+		"timestamp in future past tolerance": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(DefaultTolerance+time.Second).Unix(), 10))
+				return body
+			},
+			wantErr: ErrOutsideTolerance,
+		},
+		"custom tolerance": {
+			verifier: Verifier{Tolerance: time.Minute},
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(-2*time.Minute).Unix(), 10))
+				return body
+			},
+			wantErr: ErrOutsideTolerance,
+		},
+		"tolerance disabled": {
+			verifier: Verifier{Tolerance: -1},
+			modify: func(header http.Header, body []byte) []byte {
+				header.Del(HeaderTimestamp)
+				return body
+			},
+		},
+		"timestamp in milliseconds": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(-time.Minute).UnixMilli(), 10))
+				return body
+			},
+		},
+		"invalid timestamp": {
+			modify: func(header http.Header, body []byte) []byte {
+				header.Set(HeaderTimestamp, "yesterday")
+				return body
+			},
+			wantErr: ErrInvalidHeaderValue,
+		},
+		"missing event id with store": {
+			verifier: Verifier{Seen: NewMemoryEventIdStore(time.Hour)},
+			modify: func(header http.Header, body []byte) []byte {
+				header.Del(HeaderEventId)
+				return body
+			},
+			wantErr: ErrMissingHeader,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			verifier := test.verifier
+			if !test.emptySecret {
+				verifier.Secret = testSecret
+			}
+			verifier.Now = func() time.Time { return testNow }
+
+			header, body := testDelivery(t, testNow)
+			if test.modify != nil {
+				body = test.modify(header, body)
+			}
+
+			delivery, err := verifier.VerifyBody(header, body)
+
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Fatalf("expected error %v, got %v", test.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if delivery.Event != EventOrderUpdated || delivery.WebhookId != 2214 {
+				t.Errorf("unexpected delivery %+v", delivery)
+			}
+			if _, ok := delivery.Payload.(*Order); !ok {
+				t.Errorf("expected *Order payload, got %T", delivery.Payload)
+			}
+		})
+	}
+}
+
+func TestVerifyBodyReplayedEvent(t *testing.T) {
+	verifier := Verifier{
+		Secret: testSecret,
+		Now:    func() time.Time { return testNow },
+		Seen:   NewMemoryEventIdStore(time.Hour),
+	}
+
+	header, body := testDelivery(t, testNow)
+
+	if _, err := verifier.VerifyBody(header, body); err != nil {
+		t.Fatalf("first delivery: %v", err)
+	}
+
+	// The timestamp is not signed, so a replay can carry a fresh one.
+	header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(time.Minute).Unix(), 10))
+
+	if _, err := verifier.VerifyBody(header, body); !errors.Is(err, ErrReplayedDelivery) {
+		t.Fatalf("expected error %v, got %v", ErrReplayedDelivery, err)
+	}
+}
+
+func TestMemoryEventIdStoreZeroRetention(t *testing.T) {
+	for name, store := range map[string]*MemoryEventIdStore{
+		"constructor": NewMemoryEventIdStore(0),
+		"zero value":  {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if store.Seen("3f1c2a9e") {
+				t.Fatal("expected first event id to be new")
+			}
+			if !store.Seen("3f1c2a9e") {
+				t.Error("expected repeated event id to be seen")
+			}
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    time.Time
+		wantErr error
+	}{
+		"seconds": {
+			value: "1714564800",
+			want:  testNow,
+		},
+		"milliseconds": {
+			value: "1714564800123",
+			want:  testNow.Add(123 * time.Millisecond),
+		},
+		"largest seconds value": {
+			value: "1000000000000",
+			want:  time.Unix(1e12, 0),
+		},
+		"smallest milliseconds value": {
+			value: "1000000000001",
+			want:  time.UnixMilli(1e12 + 1),
+		},
+		"rfc 3339": {
+			value: "2024-05-01T12:00:00Z",
+			want:  testNow,
+		},
+		"empty": {
+			value:   "",
+			wantErr: ErrMissingHeader,
+		},
+		"invalid": {
+			value:   "2024-05-01",
+			wantErr: ErrInvalidHeaderValue,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseTimestamp(test.value)
+
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Fatalf("expected error %v, got %v", test.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := map[string]struct {
+		modify     func(header http.Header, body []byte)
+		wantStatus int
+	}{
+		"valid delivery": {
+			wantStatus: http.StatusOK,
+		},
+		"bad signature": {
+			modify: func(header http.Header, body []byte) {
+				header.Set(HeaderHmac, Sign(body, "other-secret"))
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"missing signature header": {
+			modify: func(header http.Header, body []byte) {
+				header.Del(HeaderHmac)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"missing topic header": {
+			modify: func(header http.Header, body []byte) {
+				header.Del(HeaderTopic)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		"timestamp outside tolerance": {
+			modify: func(header http.Header, body []byte) {
+				header.Set(HeaderTimestamp, strconv.FormatInt(testNow.Add(-time.Hour).Unix(), 10))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	verifier := &Verifier{
+		Secret: testSecret,
+		Now:    func() time.Time { return testNow },
+	}
+
+	handler := verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivery, ok := DeliveryFromContext(r.Context())
+		if !ok || delivery.Event != EventOrderUpdated {
+			t.Errorf("expected delivery in context, got %+v", delivery)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header, body := testDelivery(t, testNow)
+			if test.modify != nil {
+				test.modify(header, body)
+			}
+
+			request := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+			request.Header = header
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != test.wantStatus {
+				t.Errorf("expected status %d, got %d: %s", test.wantStatus, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestMiddlewareEmptySecret(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for empty secret")
+		}
+	}()
+
+	Middleware("")
+}